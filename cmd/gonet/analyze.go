@@ -0,0 +1,535 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/types/typeutil"
+	"strings"
+)
+
+// diPath is the di package import path
+const diPath = "github.com/akimsavvin/gonet/v2/di"
+
+// positionError is the error reported at the source position
+type positionError struct {
+	pos token.Position
+	msg string
+}
+
+// Error implements the error interface
+func (e *positionError) Error() string {
+	return fmt.Sprintf("%v: %s", e.pos, e.msg)
+}
+
+// depKind is the kind of the factory dependency
+type depKind int
+
+const (
+	// depService is the single service dependency
+	depService depKind = iota
+
+	// depSlice is the dependency on all the services of the slice element type
+	depSlice
+
+	// depContext is the context.Context dependency
+	depContext
+
+	// depServiceGetter is the di.ServiceGetter dependency
+	depServiceGetter
+)
+
+// dependency is the factory dependency
+type dependency struct {
+	kind depKind
+
+	// typ is the parameter type
+	typ types.Type
+
+	// reg is the resolved registration of the depService dependency
+	reg *registration
+
+	// group is the resolved group of the depSlice dependency
+	group *group
+}
+
+// registration is the service factory registration
+type registration struct {
+	// index is the registration index within the call site
+	index int
+
+	// pos is the registration position
+	pos token.Position
+
+	// fn is the factory function
+	fn *types.Func
+
+	// typ is the service type
+	typ types.Type
+
+	// key is the service key if keyed is true
+	key   string
+	keyed bool
+
+	// hasErr is true if the factory returns an error
+	hasErr bool
+
+	// deps are the factory dependencies
+	deps []*dependency
+}
+
+// String returns the registration service name
+func (reg *registration) String() string {
+	name := types.TypeString(reg.typ, packageName)
+	if reg.keyed {
+		return name + ":" + reg.key
+	}
+
+	return name
+}
+
+// group is the registrations of the same service type and key in the registration order
+type group struct {
+	// index is the group index within the call site
+	index int
+
+	typ   types.Type
+	key   string
+	keyed bool
+	regs  []*registration
+}
+
+// callSite is the di.NewContainer call analyzed within the function
+type callSite struct {
+	// funcName is the name of the function calling the di.NewContainer
+	funcName string
+
+	// pos is the call position
+	pos token.Position
+
+	regs   []*registration
+	groups []*group
+}
+
+// analyzer collects the di.NewContainer call sites of the package
+type analyzer struct {
+	pkg  *packages.Package
+	errs []error
+}
+
+// analyze returns the di.NewContainer call sites of the package
+// with the resolved dependencies.
+// Returns the errors of the unsupported registrations, missing dependencies and cycles
+func analyze(pkg *packages.Package) ([]*callSite, []error) {
+	a := &analyzer{pkg: pkg}
+
+	var sites []*callSite
+	seen := make(map[string]bool)
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || fn.Recv != nil {
+				continue
+			}
+
+			ast.Inspect(fn.Body, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+				if !ok || !a.isDIFunc(call, "NewContainer") {
+					return true
+				}
+
+				if seen[fn.Name.Name] {
+					a.errorf(call, "only one di.NewContainer call per function is supported")
+					return false
+				}
+				seen[fn.Name.Name] = true
+
+				if site := a.callSite(fn.Name.Name, call); site != nil {
+					sites = append(sites, site)
+				}
+
+				return false
+			})
+		}
+	}
+
+	return sites, a.errs
+}
+
+// errorf reports the error at the node position
+func (a *analyzer) errorf(node ast.Node, format string, args ...any) {
+	a.errs = append(a.errs, &positionError{
+		pos: a.pkg.Fset.Position(node.Pos()),
+		msg: fmt.Sprintf(format, args...),
+	})
+}
+
+// callee returns the di package function called with the call expression
+func (a *analyzer) callee(call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(a.pkg.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != diPath {
+		return nil
+	}
+
+	return fn
+}
+
+// isDIFunc reports whether the call expression calls the di package function with the name
+func (a *analyzer) isDIFunc(call *ast.CallExpr, name string) bool {
+	fn := a.callee(call)
+	return fn != nil && fn.Name() == name
+}
+
+// callSite analyzes the di.NewContainer call
+func (a *analyzer) callSite(funcName string, call *ast.CallExpr) *callSite {
+	site := &callSite{
+		funcName: funcName,
+		pos:      a.pkg.Fset.Position(call.Pos()),
+	}
+
+	if call.Ellipsis.IsValid() {
+		a.errorf(call, "options passed with the ellipsis are not supported")
+		return nil
+	}
+
+	errCount := len(a.errs)
+	for _, arg := range call.Args {
+		if reg := a.registration(arg); reg != nil {
+			reg.index = len(site.regs)
+			site.regs = append(site.regs, reg)
+		}
+	}
+
+	if len(a.errs) > errCount {
+		return nil
+	}
+
+	site.groups = groupRegistrations(site.regs)
+	if !a.resolve(site) || !a.checkCycles(site) {
+		return nil
+	}
+
+	return site
+}
+
+// registration analyzes the single di.NewContainer option
+func (a *analyzer) registration(arg ast.Expr) *registration {
+	call, ok := ast.Unparen(arg).(*ast.CallExpr)
+	if !ok {
+		a.errorf(arg, "only the di.WithFactory, di.WithKeyedFactory, di.WithService and di.WithKeyedService options are supported")
+		return nil
+	}
+
+	callee := a.callee(call)
+	if callee == nil {
+		a.errorf(arg, "only the di.WithFactory, di.WithKeyedFactory, di.WithService and di.WithKeyedService options are supported")
+		return nil
+	}
+
+	reg := &registration{
+		pos: a.pkg.Fset.Position(arg.Pos()),
+	}
+
+	args := call.Args
+	switch callee.Name() {
+	case "WithFactory", "WithService":
+	case "WithKeyedFactory", "WithKeyedService":
+		if len(args) == 0 {
+			return nil
+		}
+
+		tv := a.pkg.TypesInfo.Types[args[0]]
+		if tv.Value == nil || tv.Value.Kind() != constant.String {
+			a.errorf(args[0], "service key must be a constant string")
+			return nil
+		}
+
+		reg.key, reg.keyed = constant.StringVal(tv.Value), true
+		args = args[1:]
+	default:
+		a.errorf(arg, "di.%s option is not supported", callee.Name())
+		return nil
+	}
+
+	if len(args) != 1 || call.Ellipsis.IsValid() {
+		a.errorf(arg, "service options are not supported")
+		return nil
+	}
+
+	reg.fn = a.factoryFunc(args[0])
+	if reg.fn == nil {
+		return nil
+	}
+
+	sig := reg.fn.Signature()
+	res := sig.Results()
+	switch {
+	case res.Len() == 1:
+	case res.Len() == 2 && isError(res.At(1).Type()):
+		reg.hasErr = true
+	default:
+		a.errorf(args[0], "factory %s must return the service and an optional error", reg.fn.Name())
+		return nil
+	}
+
+	reg.typ = res.At(0).Type()
+	if strings.HasSuffix(callee.Name(), "Service") {
+		reg.typ = a.typeArg(call)
+		if reg.typ == nil || !types.AssignableTo(res.At(0).Type(), reg.typ) {
+			a.errorf(args[0], "factory %s return type must be assignable to the service type", reg.fn.Name())
+			return nil
+		}
+	}
+
+	for i := 0; i < sig.Params().Len(); i++ {
+		reg.deps = append(reg.deps, newDependency(sig.Params().At(i).Type()))
+	}
+
+	return reg
+}
+
+// factoryFunc returns the package-level function referenced by the expression
+func (a *analyzer) factoryFunc(expr ast.Expr) *types.Func {
+	var ident *ast.Ident
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	}
+
+	if ident != nil {
+		fn, ok := a.pkg.TypesInfo.Uses[ident].(*types.Func)
+		if ok && fn.Signature().Recv() == nil && fn.Signature().TypeParams() == nil {
+			return fn
+		}
+	}
+
+	a.errorf(expr, "factory must be a package-level non-generic function")
+	return nil
+}
+
+// typeArg returns the type argument of the generic di function call
+func (a *analyzer) typeArg(call *ast.CallExpr) types.Type {
+	fun := ast.Unparen(call.Fun)
+	switch e := fun.(type) {
+	case *ast.IndexExpr:
+		fun = e.X
+	case *ast.IndexListExpr:
+		fun = e.X
+	}
+
+	var ident *ast.Ident
+	switch e := fun.(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	}
+
+	inst, ok := a.pkg.TypesInfo.Instances[ident]
+	if !ok || inst.TypeArgs.Len() != 1 {
+		return nil
+	}
+
+	return inst.TypeArgs.At(0)
+}
+
+// newDependency creates a new dependency for the factory parameter type
+func newDependency(typ types.Type) *dependency {
+	dep := &dependency{typ: typ}
+
+	switch {
+	case isNamed(typ, "context", "Context"):
+		dep.kind = depContext
+	case isNamed(typ, diPath, "ServiceGetter"):
+		dep.kind = depServiceGetter
+	default:
+		if _, ok := typ.Underlying().(*types.Slice); ok {
+			dep.kind = depSlice
+		}
+	}
+
+	return dep
+}
+
+// groupRegistrations groups the registrations by the service type and key
+func groupRegistrations(regs []*registration) []*group {
+	var groups []*group
+	for _, reg := range regs {
+		g := findGroup(groups, reg.typ, reg.key, reg.keyed)
+		if g == nil {
+			g = &group{
+				index: len(groups),
+				typ:   reg.typ,
+				key:   reg.key,
+				keyed: reg.keyed,
+			}
+			groups = append(groups, g)
+		}
+
+		g.regs = append(g.regs, reg)
+	}
+
+	return groups
+}
+
+// findGroup returns the group of the service type and key
+func findGroup(groups []*group, typ types.Type, key string, keyed bool) *group {
+	for _, g := range groups {
+		if g.keyed == keyed && g.key == key && types.Identical(g.typ, typ) {
+			return g
+		}
+	}
+
+	return nil
+}
+
+// resolve resolves the registrations dependencies.
+// The dependencies are always resolved without a key, the same way the Container does
+func (a *analyzer) resolve(site *callSite) bool {
+	ok := true
+	for _, reg := range site.regs {
+		for _, dep := range reg.deps {
+			switch dep.kind {
+			case depService:
+				if g := findGroup(site.groups, dep.typ, "", false); g != nil {
+					dep.reg = g.regs[len(g.regs)-1]
+					continue
+				}
+
+				if isPointerTo(dep.typ, diPath, "Container") {
+					a.errs = append(a.errs, &positionError{
+						pos: reg.pos,
+						msg: fmt.Sprintf("factory %s: *di.Container dependency is not supported, use di.ServiceGetter", reg.fn.Name()),
+					})
+					ok = false
+					continue
+				}
+			case depSlice:
+				elem := dep.typ.Underlying().(*types.Slice).Elem()
+				if dep.group = findGroup(site.groups, elem, "", false); dep.group != nil {
+					continue
+				}
+			default:
+				continue
+			}
+
+			a.errs = append(a.errs, &positionError{
+				pos: reg.pos,
+				msg: fmt.Sprintf("factory %s: missing dependency %s", reg.fn.Name(), types.TypeString(dep.typ, packageName)),
+			})
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+// checkCycles reports the circular dependencies of the registrations
+func (a *analyzer) checkCycles(site *callSite) bool {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make([]int, len(site.regs))
+	var path []*registration
+
+	var visit func(reg *registration) bool
+	visit = func(reg *registration) bool {
+		switch state[reg.index] {
+		case visited:
+			return true
+		case visiting:
+			names := make([]string, 0, len(path)+1)
+			start := len(path) - 1
+			for path[start] != reg {
+				start--
+			}
+
+			for _, r := range path[start:] {
+				names = append(names, r.String())
+			}
+			names = append(names, reg.String())
+
+			a.errs = append(a.errs, &positionError{
+				pos: reg.pos,
+				msg: "circular dependency: " + strings.Join(names, " -> "),
+			})
+			return false
+		}
+
+		state[reg.index] = visiting
+		path = append(path, reg)
+
+		for _, dep := range reg.deps {
+			deps := dep.group.registrations()
+			if dep.reg != nil {
+				deps = []*registration{dep.reg}
+			}
+
+			for _, d := range deps {
+				if !visit(d) {
+					return false
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[reg.index] = visited
+		return true
+	}
+
+	for _, reg := range site.regs {
+		if !visit(reg) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// registrations returns the group registrations, nil for the nil group
+func (g *group) registrations() []*registration {
+	if g == nil {
+		return nil
+	}
+
+	return g.regs
+}
+
+// packageName qualifies the types with the package name
+func packageName(pkg *types.Package) string {
+	return pkg.Name()
+}
+
+// isError reports whether the type is the error type
+func isError(typ types.Type) bool {
+	return types.Identical(typ, types.Universe.Lookup("error").Type())
+}
+
+// isNamed reports whether the type is the named type with the package path and name
+func isNamed(typ types.Type, pkgPath, name string) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+// isPointerTo reports whether the type is the pointer to the named type with the package path and name
+func isPointerTo(typ types.Type, pkgPath, name string) bool {
+	ptr, ok := types.Unalias(typ).(*types.Pointer)
+	return ok && isNamed(ptr.Elem(), pkgPath, name)
+}
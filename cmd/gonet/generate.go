@@ -0,0 +1,271 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// header is the header of the generated files
+const header = "// Code generated by gonet gen. DO NOT EDIT.\n\n"
+
+// imports collects the imports of the generated file
+type imports struct {
+	// self is the generated file package
+	self *types.Package
+
+	// names are the import names by the import path
+	names map[string]string
+
+	// paths are the import paths by the import name
+	paths map[string]string
+
+	// used are the import paths used in the generated code
+	used map[string]bool
+}
+
+// newImports creates a new imports with the generated code dependencies
+func newImports(self *types.Package) *imports {
+	im := &imports{
+		self:  self,
+		names: make(map[string]string),
+		paths: make(map[string]string),
+		used:  make(map[string]bool),
+	}
+
+	// the generated code dependencies keep their names
+	for _, path := range []string{"context", "reflect", "sync", diPath} {
+		im.add(path, path[strings.LastIndex(path, "/")+1:])
+		im.used[path] = false
+	}
+
+	return im
+}
+
+// add adds the import used in the generated code and returns its unique name
+func (im *imports) add(path, name string) string {
+	im.used[path] = true
+	if n, ok := im.names[path]; ok {
+		return n
+	}
+
+	unique := name
+	for i := 2; im.paths[unique] != ""; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+
+	im.names[path] = unique
+	im.paths[unique] = path
+	return unique
+}
+
+// qualifier qualifies the types of the other packages with their import names
+func (im *imports) qualifier(pkg *types.Package) string {
+	if pkg == im.self {
+		return ""
+	}
+
+	return im.add(pkg.Path(), pkg.Name())
+}
+
+// write writes the import declaration
+func (im *imports) write(buf *bytes.Buffer) {
+	paths := make([]string, 0, len(im.names))
+	for path, used := range im.used {
+		if used {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	buf.WriteString("import (\n")
+	for _, path := range paths {
+		name := im.names[path]
+		if name == path[strings.LastIndex(path, "/")+1:] {
+			fmt.Fprintf(buf, "\t%q\n", path)
+		} else {
+			fmt.Fprintf(buf, "\t%s %q\n", name, path)
+		}
+	}
+	buf.WriteString(")\n\n")
+}
+
+// generator renders the call sites of a single package
+type generator struct {
+	im  *imports
+	buf bytes.Buffer
+}
+
+// generate renders the generated file of the package with the call sites
+func generate(pkg *types.Package, sites []*callSite) ([]byte, error) {
+	g := &generator{im: newImports(pkg)}
+	for _, site := range sites {
+		g.site(site)
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	fmt.Fprintf(&out, "package %s\n\n", pkg.Name())
+	g.im.write(&out)
+	out.Write(g.buf.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+// printf writes the formatted code
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// typ returns the type expression
+func (g *generator) typ(typ types.Type) string {
+	return types.TypeString(typ, g.im.qualifier)
+}
+
+// funcRef returns the factory function reference
+func (g *generator) funcRef(fn *types.Func) string {
+	if q := g.im.qualifier(fn.Pkg()); q != "" {
+		return q + "." + fn.Name()
+	}
+
+	return fn.Name()
+}
+
+// site renders the container generated from the call site
+func (g *generator) site(site *callSite) {
+	name := "gen" + upperFirst(site.funcName)
+	ctor := site.funcName + "Generated"
+
+	g.im.add("context", "context")
+	g.im.add("reflect", "reflect")
+	g.im.add(diPath, "di")
+
+	g.printf("// %s is the container generated from the %s function\n", name, site.funcName)
+	g.printf("type %s struct {\n", name)
+	for _, reg := range site.regs {
+		g.printf("mu%d %s.Mutex\ndone%d bool\nsvc%d %s\nerr%d error\n\n",
+			reg.index, g.im.add("sync", "sync"), reg.index, reg.index, g.typ(reg.typ), reg.index)
+	}
+	g.printf("}\n\n")
+
+	g.printf("// %s returns the di.ServiceGetter resolving the services registered in the %s function\n", ctor, site.funcName)
+	g.printf("// with the generated code instead of the reflection\n")
+	g.printf("func %s() di.ServiceGetter {\nreturn di.NewServiceGetter(&%s{})\n}\n\n", ctor, name)
+
+	g.printf("// Resolve implements the di.Resolver interface\n")
+	g.printf("func (c *%s) Resolve(ctx context.Context, typ reflect.Type, key string, keyed bool) (any, error) {\n", name)
+	g.printf("switch {\n")
+	g.printf("case !keyed && typ == reflect.TypeFor[di.ServiceGetter]():\nreturn di.NewServiceGetter(c), nil\n")
+	for _, grp := range site.groups {
+		cond := "!keyed"
+		if grp.keyed {
+			cond = fmt.Sprintf("keyed && key == %q", grp.key)
+		}
+
+		last := grp.regs[len(grp.regs)-1]
+		g.printf("case %s && typ == reflect.TypeFor[%s]():\nreturn c.service%d(ctx)\n", cond, g.typ(grp.typ), last.index)
+		g.printf("case %s && typ == reflect.TypeFor[[]%s]():\nreturn c.group%d(ctx)\n", cond, g.typ(grp.typ), grp.index)
+	}
+	g.printf("}\n\nreturn nil, di.ErrServiceNotFound\n}\n\n")
+
+	for _, reg := range site.regs {
+		g.service(name, reg)
+	}
+
+	for _, grp := range site.groups {
+		g.group(name, grp)
+	}
+}
+
+// service renders the method returning the registration service created once.
+//
+// Like the Container, the method does not store the error of the creation with the done context,
+// so the service is created again with the next context
+func (g *generator) service(name string, reg *registration) {
+	typ := g.typ(reg.typ)
+
+	g.printf("// service%d returns the %s service created by the %s factory\n", reg.index, reg, reg.fn.Name())
+	g.printf("func (c *%s) service%d(ctx context.Context) (%s, error) {\n", name, reg.index, typ)
+	g.printf("c.mu%d.Lock()\ndefer c.mu%d.Unlock()\n\n", reg.index, reg.index)
+	g.printf("if !c.done%d {\nsvc, err := c.create%d(ctx)\n", reg.index, reg.index)
+	g.printf("if err != nil && ctx.Err() != nil {\nreturn svc, err\n}\n\n")
+	g.printf("c.svc%d, c.err%d, c.done%d = svc, err, true\n}\n\n", reg.index, reg.index, reg.index)
+	g.printf("return c.svc%d, c.err%d\n}\n\n", reg.index, reg.index)
+
+	g.create(name, reg)
+}
+
+// create renders the method creating the registration service with its dependencies
+func (g *generator) create(name string, reg *registration) {
+	retType := g.typ(reg.fn.Signature().Results().At(0).Type())
+
+	g.printf("// create%d creates the %s service with the %s factory\n", reg.index, reg, reg.fn.Name())
+	g.printf("func (c *%s) create%d(ctx context.Context) (svc %s, err error) {\n", name, reg.index, g.typ(reg.typ))
+
+	args := make([]string, 0, len(reg.deps))
+	for i, dep := range reg.deps {
+		switch dep.kind {
+		case depContext:
+			args = append(args, "ctx")
+			continue
+		case depServiceGetter:
+			args = append(args, "di.NewServiceGetter(c)")
+			continue
+		case depService:
+			g.printf("dep%d, err := c.service%d(ctx)\n", i, dep.reg.index)
+		case depSlice:
+			g.printf("dep%d, err := c.group%d(ctx)\n", i, dep.group.index)
+		}
+
+		g.printf("if err != nil {\nreturn svc, &di.DependencyError{\n")
+		g.printf("RequestingType: reflect.TypeFor[%s](),\n", retType)
+		g.printf("DependencyType: reflect.TypeFor[%s](),\n", g.typ(dep.typ))
+		g.printf("Err: err,\n}\n}\n\n")
+		args = append(args, fmt.Sprintf("dep%d", i))
+	}
+
+	g.printf("if err = ctx.Err(); err != nil {\nreturn svc, err\n}\n\n")
+
+	call := fmt.Sprintf("%s(%s)", g.funcRef(reg.fn), strings.Join(args, ", "))
+	if reg.hasErr {
+		g.printf("return %s\n}\n\n", call)
+	} else {
+		g.printf("return %s, nil\n}\n\n", call)
+	}
+}
+
+// group renders the method returning all the group services
+func (g *generator) group(name string, grp *group) {
+	elem := g.typ(grp.typ)
+
+	g.printf("// group%d returns all the %s services\n", grp.index, grp.regs[0])
+	g.printf("func (c *%s) group%d(ctx context.Context) ([]%s, error) {\n", name, grp.index, elem)
+
+	svcs := make([]string, 0, len(grp.regs))
+	for _, reg := range grp.regs {
+		g.printf("svc%d, err := c.service%d(ctx)\nif err != nil {\nreturn nil, err\n}\n\n", reg.index, reg.index)
+		svcs = append(svcs, fmt.Sprintf("svc%d", reg.index))
+	}
+
+	g.printf("return []%s{%s}, nil\n}\n\n", elem, strings.Join(svcs, ", "))
+}
+
+// upperFirst returns the string with the first letter in upper case
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,112 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+// Command gonet is the GoNet framework tool.
+//
+// The gen command generates the containers wiring the services without the reflection:
+//
+//	gonet gen [-o gonet_gen.go] [packages]
+//
+// For every function calling di.NewContainer with the di.WithFactory, di.WithKeyedFactory,
+// di.WithService and di.WithKeyedService options registering the package-level factory functions,
+// the command generates the <function>Generated function returning the di.ServiceGetter
+// creating the Singleton services with the plain Go code.
+// The missing dependencies, the circular dependencies and the unsupported options
+// are reported as errors and no code is generated for the package.
+//
+// Add the go:generate directive to regenerate the containers with the go generate command:
+//
+//	//go:generate go run github.com/akimsavvin/gonet/v2/cmd/gonet gen
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"golang.org/x/tools/go/packages"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// defaultOutput is the default name of the generated file
+const defaultOutput = "gonet_gen.go"
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+// run runs the command with the arguments and returns the exit code
+func run(args []string, stderr io.Writer) int {
+	if len(args) == 0 || args[0] != "gen" {
+		fmt.Fprintln(stderr, "usage: gonet gen [-o file] [packages]")
+		return 2
+	}
+
+	fs := flag.NewFlagSet("gen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	output := fs.String("o", defaultOutput, "name of the generated file")
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	if err := gen(patterns, *output); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	return 0
+}
+
+// gen generates the containers of the packages matching the patterns
+// into the output file of every package
+func gen(patterns []string, output string) error {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax |
+			packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps,
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return fmt.Errorf("gonet: failed to load packages: %w", err)
+	}
+
+	var errs []error
+	for _, pkg := range pkgs {
+		if len(pkg.GoFiles) == 0 || pkg.Types == nil {
+			for _, pkgErr := range pkg.Errors {
+				errs = append(errs, pkgErr)
+			}
+			continue
+		}
+
+		sites, siteErrs := analyze(pkg)
+		if len(siteErrs) > 0 {
+			errs = append(errs, siteErrs...)
+			continue
+		}
+
+		if len(sites) == 0 {
+			continue
+		}
+
+		src, err := generate(pkg.Types, sites)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("gonet: failed to generate %s: %w", pkg.PkgPath, err))
+			continue
+		}
+
+		path := filepath.Join(filepath.Dir(pkg.GoFiles[0]), output)
+		if err = os.WriteFile(path, src, 0o644); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
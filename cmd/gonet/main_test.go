@@ -0,0 +1,170 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package main
+
+import (
+	"context"
+	"github.com/akimsavvin/gonet/v2/cmd/gonet/testdata/app"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// GenSuite is the suite for testing the gen command
+type GenSuite struct {
+	suite.Suite
+}
+
+// TestGolden tests that the generated code matches the committed testdata/app/gonet_gen.go
+func (suite *GenSuite) TestGolden() {
+	// Arrange
+	const output = "gonet_gen_golden.go"
+	path := filepath.Join("testdata", "app", output)
+	defer os.Remove(path)
+
+	golden, err := os.ReadFile(filepath.Join("testdata", "app", defaultOutput))
+	suite.Require().NoError(err)
+
+	// Act
+	err = gen([]string{"./testdata/app"}, output)
+
+	// Assert
+	suite.Require().NoError(err)
+
+	generated, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Equal(string(golden), string(generated))
+}
+
+// TestErrors tests that the unsupported options, missing and circular dependencies are reported
+func (suite *GenSuite) TestErrors() {
+	// Act
+	err := gen([]string{"./testdata/invalid"}, defaultOutput)
+
+	// Assert
+	suite.Require().Error(err)
+	suite.ErrorContains(err, "invalid.go:25:3: circular dependency: *invalid.A -> *invalid.B -> *invalid.A")
+	suite.ErrorContains(err, "invalid.go:32:3: factory newC: missing dependency string")
+	suite.ErrorContains(err, "invalid.go:38:3: di.WithValue option is not supported")
+	suite.ErrorContains(err, "invalid.go:39:18: factory must be a package-level non-generic function")
+	suite.NoFileExists(filepath.Join("testdata", "invalid", defaultOutput))
+}
+
+// TestUsage tests that the usage is printed for the unknown command
+func (suite *GenSuite) TestUsage() {
+	// Act
+	code := run([]string{"build"}, io.Discard)
+
+	// Assert
+	suite.Equal(2, code)
+}
+
+// TestGen runs the GenSuite
+func TestGen(t *testing.T) {
+	suite.Run(t, new(GenSuite))
+}
+
+// cancelledContext is the context cancelled after its Err method is called checks times
+type cancelledContext struct {
+	context.Context
+	checks int
+}
+
+// Err returns context.Canceled after the checks
+func (ctx *cancelledContext) Err() error {
+	if ctx.checks > 0 {
+		ctx.checks--
+		return nil
+	}
+
+	return context.Canceled
+}
+
+// GeneratedSuite is the suite for testing the generated container
+type GeneratedSuite struct {
+	suite.Suite
+	sg di.ServiceGetter
+}
+
+// SetupTest creates the generated container
+func (suite *GeneratedSuite) SetupTest() {
+	suite.sg = app.NewContainerGenerated()
+}
+
+// TestDependencies tests that the services are created with their dependencies
+func (suite *GeneratedSuite) TestDependencies() {
+	// Act
+	router, err := di.GetService[*app.Router](suite.sg)
+
+	// Assert
+	suite.Require().NoError(err)
+	if suite.Len(router.Handlers, 2) {
+		suite.Equal("users", router.Handlers[0].Name())
+		suite.Equal("orders", router.Handlers[1].Name())
+	}
+
+	db := di.MustGetService[*app.DB](router.Getter)
+	suite.Same(di.MustGetService[*app.Config](suite.sg), db.Config)
+}
+
+// TestLastRegistration tests that the last registration of the service type is resolved
+func (suite *GeneratedSuite) TestLastRegistration() {
+	// Act
+	handler, err := di.GetService[app.Handler](suite.sg)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal("orders", handler.Name())
+}
+
+// TestKeyed tests that the keyed services are resolved by the key
+func (suite *GeneratedSuite) TestKeyed() {
+	// Act
+	primary, err := di.GetKeyedService[*app.Config](suite.sg, "primary")
+
+	// Assert
+	suite.NoError(err)
+	suite.NotSame(di.MustGetService[*app.Config](suite.sg), primary)
+
+	_, err = di.GetKeyedService[*app.Config](suite.sg, "secondary")
+	suite.ErrorIs(err, di.ErrServiceNotFound)
+}
+
+// TestCancelled tests that the cancelled context stops the resolution
+func (suite *GeneratedSuite) TestCancelled() {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	_, err := di.GetServiceCtx[*app.DB](ctx, suite.sg)
+
+	// Assert
+	suite.ErrorIs(err, context.Canceled)
+}
+
+// TestCancelledNotCached tests that the service creation cancelled during the resolution
+// is not cached and the service is created with the next context
+func (suite *GeneratedSuite) TestCancelledNotCached() {
+	// Arrange
+	ctx := &cancelledContext{Context: context.Background(), checks: 1}
+
+	// Act
+	_, err := di.GetServiceCtx[*app.DB](ctx, suite.sg)
+	db, retryErr := di.GetService[*app.DB](suite.sg)
+
+	// Assert
+	suite.ErrorIs(err, context.Canceled)
+	suite.NoError(retryErr)
+	suite.NotNil(db)
+}
+
+// TestGenerated runs the GeneratedSuite
+func TestGenerated(t *testing.T) {
+	suite.Run(t, new(GeneratedSuite))
+}
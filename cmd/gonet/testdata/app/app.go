@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+)
+
+type Config struct {
+	DSN string
+}
+
+func NewConfig() *Config {
+	return &Config{DSN: "postgres://localhost"}
+}
+
+type DB struct {
+	Config *Config
+}
+
+func NewDB(ctx context.Context, cfg *Config) (*DB, error) {
+	if cfg.DSN == "" {
+		return nil, errors.New("empty dsn")
+	}
+
+	return &DB{Config: cfg}, nil
+}
+
+type Handler interface {
+	Name() string
+}
+
+type userHandler struct {
+	db *DB
+}
+
+func (h *userHandler) Name() string {
+	return "users"
+}
+
+func newUserHandler(db *DB) *userHandler {
+	return &userHandler{db: db}
+}
+
+type orderHandler struct{}
+
+func (h *orderHandler) Name() string {
+	return "orders"
+}
+
+func newOrderHandler() Handler {
+	return &orderHandler{}
+}
+
+type Router struct {
+	Handlers []Handler
+	Getter   di.ServiceGetter
+}
+
+func NewRouter(handlers []Handler, sg di.ServiceGetter) *Router {
+	return &Router{Handlers: handlers, Getter: sg}
+}
+
+func NewContainer() *di.Container {
+	return di.NewContainer(
+		di.WithFactory(NewConfig),
+		di.WithFactory(NewDB),
+		di.WithService[Handler](newUserHandler),
+		di.WithFactory(newOrderHandler),
+		di.WithKeyedFactory("primary", NewConfig),
+		di.WithFactory(NewRouter),
+	)
+}
@@ -0,0 +1,317 @@
+// Code generated by gonet gen. DO NOT EDIT.
+
+package app
+
+import (
+	"context"
+	"github.com/akimsavvin/gonet/v2/di"
+	"reflect"
+	"sync"
+)
+
+// genNewContainer is the container generated from the NewContainer function
+type genNewContainer struct {
+	mu0   sync.Mutex
+	done0 bool
+	svc0  *Config
+	err0  error
+
+	mu1   sync.Mutex
+	done1 bool
+	svc1  *DB
+	err1  error
+
+	mu2   sync.Mutex
+	done2 bool
+	svc2  Handler
+	err2  error
+
+	mu3   sync.Mutex
+	done3 bool
+	svc3  Handler
+	err3  error
+
+	mu4   sync.Mutex
+	done4 bool
+	svc4  *Config
+	err4  error
+
+	mu5   sync.Mutex
+	done5 bool
+	svc5  *Router
+	err5  error
+}
+
+// NewContainerGenerated returns the di.ServiceGetter resolving the services registered in the NewContainer function
+// with the generated code instead of the reflection
+func NewContainerGenerated() di.ServiceGetter {
+	return di.NewServiceGetter(&genNewContainer{})
+}
+
+// Resolve implements the di.Resolver interface
+func (c *genNewContainer) Resolve(ctx context.Context, typ reflect.Type, key string, keyed bool) (any, error) {
+	switch {
+	case !keyed && typ == reflect.TypeFor[di.ServiceGetter]():
+		return di.NewServiceGetter(c), nil
+	case !keyed && typ == reflect.TypeFor[*Config]():
+		return c.service0(ctx)
+	case !keyed && typ == reflect.TypeFor[[]*Config]():
+		return c.group0(ctx)
+	case !keyed && typ == reflect.TypeFor[*DB]():
+		return c.service1(ctx)
+	case !keyed && typ == reflect.TypeFor[[]*DB]():
+		return c.group1(ctx)
+	case !keyed && typ == reflect.TypeFor[Handler]():
+		return c.service3(ctx)
+	case !keyed && typ == reflect.TypeFor[[]Handler]():
+		return c.group2(ctx)
+	case keyed && key == "primary" && typ == reflect.TypeFor[*Config]():
+		return c.service4(ctx)
+	case keyed && key == "primary" && typ == reflect.TypeFor[[]*Config]():
+		return c.group3(ctx)
+	case !keyed && typ == reflect.TypeFor[*Router]():
+		return c.service5(ctx)
+	case !keyed && typ == reflect.TypeFor[[]*Router]():
+		return c.group4(ctx)
+	}
+
+	return nil, di.ErrServiceNotFound
+}
+
+// service0 returns the *app.Config service created by the NewConfig factory
+func (c *genNewContainer) service0(ctx context.Context) (*Config, error) {
+	c.mu0.Lock()
+	defer c.mu0.Unlock()
+
+	if !c.done0 {
+		svc, err := c.create0(ctx)
+		if err != nil && ctx.Err() != nil {
+			return svc, err
+		}
+
+		c.svc0, c.err0, c.done0 = svc, err, true
+	}
+
+	return c.svc0, c.err0
+}
+
+// create0 creates the *app.Config service with the NewConfig factory
+func (c *genNewContainer) create0(ctx context.Context) (svc *Config, err error) {
+	if err = ctx.Err(); err != nil {
+		return svc, err
+	}
+
+	return NewConfig(), nil
+}
+
+// service1 returns the *app.DB service created by the NewDB factory
+func (c *genNewContainer) service1(ctx context.Context) (*DB, error) {
+	c.mu1.Lock()
+	defer c.mu1.Unlock()
+
+	if !c.done1 {
+		svc, err := c.create1(ctx)
+		if err != nil && ctx.Err() != nil {
+			return svc, err
+		}
+
+		c.svc1, c.err1, c.done1 = svc, err, true
+	}
+
+	return c.svc1, c.err1
+}
+
+// create1 creates the *app.DB service with the NewDB factory
+func (c *genNewContainer) create1(ctx context.Context) (svc *DB, err error) {
+	dep1, err := c.service0(ctx)
+	if err != nil {
+		return svc, &di.DependencyError{
+			RequestingType: reflect.TypeFor[*DB](),
+			DependencyType: reflect.TypeFor[*Config](),
+			Err:            err,
+		}
+	}
+
+	if err = ctx.Err(); err != nil {
+		return svc, err
+	}
+
+	return NewDB(ctx, dep1)
+}
+
+// service2 returns the app.Handler service created by the newUserHandler factory
+func (c *genNewContainer) service2(ctx context.Context) (Handler, error) {
+	c.mu2.Lock()
+	defer c.mu2.Unlock()
+
+	if !c.done2 {
+		svc, err := c.create2(ctx)
+		if err != nil && ctx.Err() != nil {
+			return svc, err
+		}
+
+		c.svc2, c.err2, c.done2 = svc, err, true
+	}
+
+	return c.svc2, c.err2
+}
+
+// create2 creates the app.Handler service with the newUserHandler factory
+func (c *genNewContainer) create2(ctx context.Context) (svc Handler, err error) {
+	dep0, err := c.service1(ctx)
+	if err != nil {
+		return svc, &di.DependencyError{
+			RequestingType: reflect.TypeFor[*userHandler](),
+			DependencyType: reflect.TypeFor[*DB](),
+			Err:            err,
+		}
+	}
+
+	if err = ctx.Err(); err != nil {
+		return svc, err
+	}
+
+	return newUserHandler(dep0), nil
+}
+
+// service3 returns the app.Handler service created by the newOrderHandler factory
+func (c *genNewContainer) service3(ctx context.Context) (Handler, error) {
+	c.mu3.Lock()
+	defer c.mu3.Unlock()
+
+	if !c.done3 {
+		svc, err := c.create3(ctx)
+		if err != nil && ctx.Err() != nil {
+			return svc, err
+		}
+
+		c.svc3, c.err3, c.done3 = svc, err, true
+	}
+
+	return c.svc3, c.err3
+}
+
+// create3 creates the app.Handler service with the newOrderHandler factory
+func (c *genNewContainer) create3(ctx context.Context) (svc Handler, err error) {
+	if err = ctx.Err(); err != nil {
+		return svc, err
+	}
+
+	return newOrderHandler(), nil
+}
+
+// service4 returns the *app.Config:primary service created by the NewConfig factory
+func (c *genNewContainer) service4(ctx context.Context) (*Config, error) {
+	c.mu4.Lock()
+	defer c.mu4.Unlock()
+
+	if !c.done4 {
+		svc, err := c.create4(ctx)
+		if err != nil && ctx.Err() != nil {
+			return svc, err
+		}
+
+		c.svc4, c.err4, c.done4 = svc, err, true
+	}
+
+	return c.svc4, c.err4
+}
+
+// create4 creates the *app.Config:primary service with the NewConfig factory
+func (c *genNewContainer) create4(ctx context.Context) (svc *Config, err error) {
+	if err = ctx.Err(); err != nil {
+		return svc, err
+	}
+
+	return NewConfig(), nil
+}
+
+// service5 returns the *app.Router service created by the NewRouter factory
+func (c *genNewContainer) service5(ctx context.Context) (*Router, error) {
+	c.mu5.Lock()
+	defer c.mu5.Unlock()
+
+	if !c.done5 {
+		svc, err := c.create5(ctx)
+		if err != nil && ctx.Err() != nil {
+			return svc, err
+		}
+
+		c.svc5, c.err5, c.done5 = svc, err, true
+	}
+
+	return c.svc5, c.err5
+}
+
+// create5 creates the *app.Router service with the NewRouter factory
+func (c *genNewContainer) create5(ctx context.Context) (svc *Router, err error) {
+	dep0, err := c.group2(ctx)
+	if err != nil {
+		return svc, &di.DependencyError{
+			RequestingType: reflect.TypeFor[*Router](),
+			DependencyType: reflect.TypeFor[[]Handler](),
+			Err:            err,
+		}
+	}
+
+	if err = ctx.Err(); err != nil {
+		return svc, err
+	}
+
+	return NewRouter(dep0, di.NewServiceGetter(c)), nil
+}
+
+// group0 returns all the *app.Config services
+func (c *genNewContainer) group0(ctx context.Context) ([]*Config, error) {
+	svc0, err := c.service0(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Config{svc0}, nil
+}
+
+// group1 returns all the *app.DB services
+func (c *genNewContainer) group1(ctx context.Context) ([]*DB, error) {
+	svc1, err := c.service1(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*DB{svc1}, nil
+}
+
+// group2 returns all the app.Handler services
+func (c *genNewContainer) group2(ctx context.Context) ([]Handler, error) {
+	svc2, err := c.service2(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	svc3, err := c.service3(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Handler{svc2, svc3}, nil
+}
+
+// group3 returns all the *app.Config:primary services
+func (c *genNewContainer) group3(ctx context.Context) ([]*Config, error) {
+	svc4, err := c.service4(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Config{svc4}, nil
+}
+
+// group4 returns all the *app.Router services
+func (c *genNewContainer) group4(ctx context.Context) ([]*Router, error) {
+	svc5, err := c.service5(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Router{svc5}, nil
+}
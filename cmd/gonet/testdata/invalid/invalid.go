@@ -0,0 +1,41 @@
+package invalid
+
+import "github.com/akimsavvin/gonet/v2/di"
+
+type A struct{}
+
+type B struct{}
+
+type C struct{}
+
+func newA(b *B) *A {
+	return &A{}
+}
+
+func newB(a *A) *B {
+	return &B{}
+}
+
+func newC(s string) *C {
+	return &C{}
+}
+
+func cycle() *di.Container {
+	return di.NewContainer(
+		di.WithFactory(newA),
+		di.WithFactory(newB),
+	)
+}
+
+func missing() *di.Container {
+	return di.NewContainer(
+		di.WithFactory(newC),
+	)
+}
+
+func unsupported() *di.Container {
+	return di.NewContainer(
+		di.WithValue(C{}),
+		di.WithFactory(func() *A { return &A{} }),
+	)
+}
@@ -0,0 +1,148 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/akimsavvin/gonet/v2/internal/value"
+	"reflect"
+	"strings"
+)
+
+var (
+	// ErrRequired is the error returned when a required value is missing in every source
+	ErrRequired = errors.New("config: required value is missing")
+
+	// ErrInvalidValue is the error returned when a raw value can not be parsed to the field type
+	ErrInvalidValue = errors.New("config: invalid value")
+)
+
+const (
+	// keyTag is the struct tag overriding the field key
+	keyTag = "config"
+
+	// defaultTag is the struct tag setting the raw field value used when it is missing in every source
+	defaultTag = "default"
+
+	// requiredTag is the struct tag marking the field required when set to "true"
+	requiredTag = "required"
+)
+
+// Bind binds the values from the provided sources to a new struct of type T.
+//
+// The sources are loaded in order and the latter ones override the former ones.
+// The field key is its lowercase name or the "config" tag value, the "-" tag value skips the field.
+// The nested struct fields keys are prefixed with the struct field key and a dot.
+// The "default" tag sets the value used when the key is missing in every source
+// and the "required" tag set to "true" reports the missing key as the ErrRequired.
+//
+// All the missing and invalid values are reported at once, joined in the returned error
+func Bind[T any](sources ...Source) (T, error) {
+	var cfg T
+
+	for _, src := range sources {
+		if err := src.Load(); err != nil {
+			return cfg, err
+		}
+	}
+
+	val := reflect.ValueOf(&cfg).Elem()
+	if val.Kind() != reflect.Struct {
+		return cfg, fmt.Errorf("config: %q is not a struct", val.Type())
+	}
+
+	return cfg, errors.Join(bindStruct(val, "", sources)...)
+}
+
+// Register adds the Options[T] bound from the provided sources to the Container.
+//
+// The options are bound and validated when the Container is built,
+// so the binding errors and the T.Validate method error fail the NewContainer call
+func Register[T any](sources ...Source) di.Option {
+	return di.WithFactory(newOptionsFactory[T](sources), di.ValidateOnBuild())
+}
+
+// RegisterNamed adds the Options[T] bound from the provided sources
+// to the Container with the provided name as the service key.
+//
+// The named options are resolved with the di.GetKeyedService[di.Options[T]] function
+// and validated the same way as the Register ones
+func RegisterNamed[T any](name string, sources ...Source) di.Option {
+	return di.WithKeyedFactory(name, newOptionsFactory[T](sources), di.ValidateOnBuild())
+}
+
+// newOptionsFactory returns the Options[T] factory binding the provided sources
+func newOptionsFactory[T any](sources []Source) func() (di.Options[T], error) {
+	return func() (di.Options[T], error) {
+		cfg, err := Bind[T](sources...)
+		if err != nil {
+			return di.Options[T]{}, err
+		}
+
+		return di.NewOptions(cfg), nil
+	}
+}
+
+// bindStruct binds the values from the sources to the struct fields
+func bindStruct(val reflect.Value, prefix string, sources []Source) []error {
+	var errs []error
+
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := field.Tag.Lookup(keyTag)
+		if name == "-" {
+			continue
+		} else if !ok {
+			name = strings.ToLower(field.Name)
+		}
+
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+
+		fieldVal := val.Field(i)
+		if value.IsStruct(fieldVal) {
+			errs = append(errs, bindStruct(fieldVal, key, sources)...)
+			continue
+		}
+
+		raw, ok := lookup(sources, key)
+		if !ok {
+			raw, ok = field.Tag.Lookup(defaultTag)
+		}
+
+		if !ok {
+			if field.Tag.Get(requiredTag) == "true" {
+				errs = append(errs, fmt.Errorf("%w: %q", ErrRequired, key))
+			}
+			continue
+		}
+
+		if err := value.Set(fieldVal, raw); err != nil {
+			errs = append(errs, fmt.Errorf("%w %q for %q: %w", ErrInvalidValue, raw, key, err))
+		}
+	}
+
+	return errs
+}
+
+// lookup returns the raw value for the provided key from the last source containing it
+func lookup(sources []Source, key string) (string, bool) {
+	for i := len(sources) - 1; i >= 0; i-- {
+		if raw, ok := sources[i].Lookup(key); ok {
+			return raw, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,229 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package config
+
+import (
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"strings"
+	"testing"
+	"time"
+)
+
+// dbConfig is the nested configuration used in the tests
+type dbConfig struct {
+	Host    string        `required:"true"`
+	Port    int           `default:"5432"`
+	Timeout time.Duration `config:"timeout" default:"1s"`
+}
+
+// appConfig is the configuration used in the tests
+type appConfig struct {
+	Name    string   `config:"app_name" required:"true"`
+	Debug   bool     `default:"false"`
+	Tags    []string `config:"tags"`
+	DB      dbConfig `config:"db"`
+	Ignored string   `config:"-"`
+	secret  string
+}
+
+// BindSuite is the suite for testing the Bind function
+type BindSuite struct {
+	suite.Suite
+}
+
+// TestValues tests the values are bound to the struct
+func (suite *BindSuite) TestValues() {
+	// Arrange
+	src := Map(map[string]string{
+		"app_name":   "app",
+		"debug":      "true",
+		"tags":       "a, b",
+		"db.host":    "localhost",
+		"db.port":    "6432",
+		"db.timeout": "5s",
+		"ignored":    "value",
+		"secret":     "value",
+	})
+
+	// Act
+	cfg, err := Bind[appConfig](src)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(appConfig{
+		Name:  "app",
+		Debug: true,
+		Tags:  []string{"a", "b"},
+		DB: dbConfig{
+			Host:    "localhost",
+			Port:    6432,
+			Timeout: 5 * time.Second,
+		},
+	}, cfg)
+}
+
+// TestDefaults tests the default values are used for the missing keys
+func (suite *BindSuite) TestDefaults() {
+	// Arrange
+	src := Map(map[string]string{
+		"app_name": "app",
+		"db.host":  "localhost",
+	})
+
+	// Act
+	cfg, err := Bind[appConfig](src)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(5432, cfg.DB.Port)
+	suite.Equal(time.Second, cfg.DB.Timeout)
+	suite.False(cfg.Debug)
+}
+
+// TestOverride tests the latter sources override the former ones
+func (suite *BindSuite) TestOverride() {
+	// Arrange
+	src1 := Map(map[string]string{
+		"app_name": "app1",
+		"db.host":  "host1",
+	})
+	src2 := Map(map[string]string{
+		"app_name": "app2",
+	})
+
+	// Act
+	cfg, err := Bind[appConfig](src1, src2)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal("app2", cfg.Name)
+	suite.Equal("host1", cfg.DB.Host)
+}
+
+// TestErrors tests all the missing and invalid values are reported
+func (suite *BindSuite) TestErrors() {
+	// Arrange
+	src := Map(map[string]string{
+		"debug":   "yes",
+		"db.port": "port",
+	})
+
+	// Act
+	_, err := Bind[appConfig](src)
+
+	// Assert
+	suite.ErrorIs(err, ErrRequired)
+	suite.ErrorIs(err, ErrInvalidValue)
+	suite.ErrorContains(err, `"app_name"`)
+	suite.ErrorContains(err, `"db.host"`)
+	suite.ErrorContains(err, `"debug"`)
+	suite.ErrorContains(err, `"db.port"`)
+}
+
+// TestNotStruct tests the non-struct type is rejected
+func (suite *BindSuite) TestNotStruct() {
+	// Act
+	_, err := Bind[string]()
+
+	// Assert
+	suite.Error(err)
+}
+
+// TestBind tests the Bind function
+func TestBind(t *testing.T) {
+	suite.Run(t, new(BindSuite))
+}
+
+// TestRegister tests the Register function
+func TestRegister(t *testing.T) {
+	// Arrange
+	src := Map(map[string]string{
+		"app_name": "app",
+		"db.host":  "localhost",
+	})
+	c := di.NewContainer(
+		Register[appConfig](src),
+		di.WithFactory(func(opts di.Options[appConfig]) string {
+			return opts.Value.Name
+		}),
+	)
+
+	// Act
+	res, err := di.GetService[string](c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "app", res)
+}
+
+// clientConfig is the validated configuration used in the tests
+type clientConfig struct {
+	URL     string
+	Retries int
+}
+
+// Validate implements the di.Validator interface
+func (cfg clientConfig) Validate() error {
+	var errs []error
+	if cfg.URL == "" {
+		errs = append(errs, errors.New("url is required"))
+	}
+
+	if cfg.Retries < 0 {
+		errs = append(errs, errors.New("retries must not be negative"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// TestRegisterNamed tests the named options are resolved by the key
+func TestRegisterNamed(t *testing.T) {
+	// Arrange
+	src := Map(map[string]string{
+		"payments.url": "https://payments",
+		"search.url":   "https://search",
+	})
+	c := di.NewContainer(
+		RegisterNamed[clientConfig]("payments", Section(src, "payments")),
+		RegisterNamed[clientConfig]("search", Section(src, "search")),
+	)
+
+	// Act
+	payments, err1 := di.GetKeyedService[di.Options[clientConfig]](c, "payments")
+	search, err2 := di.GetKeyedService[di.Options[clientConfig]](c, "search")
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, "https://payments", payments.Value.URL)
+	assert.Equal(t, "https://search", search.Value.URL)
+}
+
+// TestRegisterValidation tests every violation fails the container build
+func TestRegisterValidation(t *testing.T) {
+	// Arrange
+	src := Map(map[string]string{
+		"payments.retries": "-1",
+		"search.url":       "https://search",
+		"search.retries":   "many",
+	})
+
+	// Act & Assert
+	assert.PanicsWithValue(t, strings.Join([]string{
+		"di: services validation failed:",
+		`	[di.Options[github.com/akimsavvin/gonet/v2/config.clientConfig]:payments]: url is required`,
+		`	retries must not be negative`,
+		`	[di.Options[github.com/akimsavvin/gonet/v2/config.clientConfig]:search]: ` +
+			`config: invalid value "many" for "retries": strconv.ParseInt: parsing "many": invalid syntax`,
+	}, "\n"), func() {
+		di.NewContainer(
+			RegisterNamed[clientConfig]("payments", Section(src, "payments")),
+			RegisterNamed[clientConfig]("search", Section(src, "search")),
+		)
+	})
+}
@@ -0,0 +1,275 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package config
+
+import (
+	"context"
+	"github.com/akimsavvin/gonet/v2/di"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Watcher notifies the OptionsMonitor about the configuration changes
+type Watcher interface {
+	// Watch calls notify on every change until the context is done
+	Watch(ctx context.Context, notify func())
+}
+
+// fileWatcher is the Watcher polling the file modification time and size
+type fileWatcher struct {
+	path     string
+	interval time.Duration
+
+	// initial is the file state when the watcher was created
+	initial fileState
+}
+
+// WatchFile returns the Watcher checking the file modification time and size every interval.
+//
+// The changes made after the WatchFile call are detected
+func WatchFile(path string, interval time.Duration) Watcher {
+	w := &fileWatcher{
+		path:     path,
+		interval: interval,
+	}
+	w.initial = w.stat()
+
+	return w
+}
+
+// Watch implements the Watcher interface
+func (w *fileWatcher) Watch(ctx context.Context, notify func()) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	last := w.initial
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if cur := w.stat(); cur != last {
+			last = cur
+			notify()
+		}
+	}
+}
+
+// fileState is the watched file modification time and size
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// stat returns the watched file state or the zero state if the file does not exist
+func (w *fileWatcher) stat() fileState {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return fileState{}
+	}
+
+	return fileState{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}
+}
+
+// signalWatcher is the Watcher notifying on the OS signals
+type signalWatcher struct {
+	signals []os.Signal
+}
+
+// WatchSignal returns the Watcher notifying on the provided signals,
+// the SIGHUP one if no signals are provided
+func WatchSignal(signals ...os.Signal) Watcher {
+	if len(signals) == 0 {
+		signals = []os.Signal{syscall.SIGHUP}
+	}
+
+	return &signalWatcher{
+		signals: signals,
+	}
+}
+
+// Watch implements the Watcher interface
+func (w *signalWatcher) Watch(ctx context.Context, notify func()) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, w.signals...)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			notify()
+		}
+	}
+}
+
+// OptionsMonitor provides the options of type T
+// reloaded from the sources when any of the watchers notifies about a change
+type OptionsMonitor[T any] struct {
+	// sources are the options sources
+	sources []Source
+
+	// reloadMu serializes the reloads, so the sources are never loaded concurrently
+	// and the listeners are notified in the reloads order
+	reloadMu sync.Mutex
+
+	// mu protects the current value and the listeners
+	mu sync.RWMutex
+
+	// current is the last valid options value
+	current T
+
+	// onChange are the listeners called with the changed value
+	onChange []func(T)
+
+	// onError are the listeners called with the reload error
+	onError []func(error)
+
+	// cancel stops the watchers
+	cancel context.CancelFunc
+
+	// wg waits for the watchers to stop
+	wg sync.WaitGroup
+}
+
+// NewOptionsMonitor binds the options from the provided sources
+// and starts the provided watchers reloading them.
+//
+// Returns the binding or the validation error of the initial options
+func NewOptionsMonitor[T any](sources []Source, watchers ...Watcher) (*OptionsMonitor[T], error) {
+	m := &OptionsMonitor[T]{
+		sources: sources,
+	}
+
+	current, err := m.bind()
+	if err != nil {
+		return nil, err
+	}
+	m.current = current
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	for _, w := range watchers {
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			w.Watch(ctx, m.reload)
+		}()
+	}
+
+	return m, nil
+}
+
+// RegisterMonitor adds the *OptionsMonitor[T] reloading the options from the provided sources
+// on the watchers notifications to the Container.
+//
+// The initial options are bound and validated when the Container is built,
+// the watchers are stopped when the Container is closed
+func RegisterMonitor[T any](sources []Source, watchers ...Watcher) di.Option {
+	return di.WithFactory(func() (*OptionsMonitor[T], error) {
+		return NewOptionsMonitor[T](sources, watchers...)
+	}, di.ValidateOnBuild())
+}
+
+// Current returns the last valid options value
+func (m *OptionsMonitor[T]) Current() T {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.current
+}
+
+// OnChange adds the listener called with the new options value after every change
+func (m *OptionsMonitor[T]) OnChange(listener func(T)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.onChange = append(m.onChange, listener)
+}
+
+// OnError adds the listener called with the reload error,
+// the current value is kept when the reload fails
+func (m *OptionsMonitor[T]) OnError(listener func(error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.onError = append(m.onError, listener)
+}
+
+// Reload binds and validates the options from the sources,
+// replaces the current value and notifies the listeners if the value has changed.
+//
+// The reloads are serialized, the listeners must not call Reload.
+//
+// Returns the binding or the validation error keeping the current value
+func (m *OptionsMonitor[T]) Reload() error {
+	m.reloadMu.Lock()
+	defer m.reloadMu.Unlock()
+
+	value, err := m.bind()
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	if reflect.DeepEqual(m.current, value) {
+		m.mu.Unlock()
+		return nil
+	}
+
+	m.current = value
+	listeners := m.onChange
+	m.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener(value)
+	}
+
+	return nil
+}
+
+// Close stops the watchers and waits for them to return
+func (m *OptionsMonitor[T]) Close() error {
+	m.cancel()
+	m.wg.Wait()
+	return nil
+}
+
+// reload reloads the options notifying the error listeners on failure
+func (m *OptionsMonitor[T]) reload() {
+	err := m.Reload()
+	if err == nil {
+		return
+	}
+
+	m.mu.RLock()
+	listeners := m.onError
+	m.mu.RUnlock()
+
+	for _, listener := range listeners {
+		listener(err)
+	}
+}
+
+// bind binds and validates the options from the sources
+func (m *OptionsMonitor[T]) bind() (T, error) {
+	value, err := Bind[T](m.sources...)
+	if err != nil {
+		return value, err
+	}
+
+	return value, di.NewOptions(value).Validate()
+}
@@ -0,0 +1,203 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package config
+
+import (
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// logConfig is the reloaded configuration used in the tests
+type logConfig struct {
+	Level string `required:"true"`
+}
+
+// OptionsMonitorSuite is the suite for testing the OptionsMonitor
+type OptionsMonitorSuite struct {
+	suite.Suite
+}
+
+// TestReload tests the changed value is set and the listeners are notified
+func (suite *OptionsMonitorSuite) TestReload() {
+	// Arrange
+	values := map[string]string{"level": "info"}
+	m, err := NewOptionsMonitor[logConfig]([]Source{Map(values)})
+	suite.Require().NoError(err)
+	defer m.Close()
+
+	var changes []logConfig
+	m.OnChange(func(cfg logConfig) {
+		changes = append(changes, cfg)
+	})
+
+	// Act
+	values["level"] = "debug"
+	err1 := m.Reload()
+	err2 := m.Reload()
+
+	// Assert
+	suite.NoError(err1)
+	suite.NoError(err2)
+	suite.Equal(logConfig{Level: "debug"}, m.Current())
+	suite.Equal([]logConfig{{Level: "debug"}}, changes)
+}
+
+// TestReloadError tests the current value is kept when the reload fails
+func (suite *OptionsMonitorSuite) TestReloadError() {
+	// Arrange
+	values := map[string]string{"level": "info"}
+	m, err := NewOptionsMonitor[logConfig]([]Source{Map(values)})
+	suite.Require().NoError(err)
+	defer m.Close()
+
+	// Act
+	delete(values, "level")
+	err = m.Reload()
+
+	// Assert
+	suite.ErrorIs(err, ErrRequired)
+	suite.Equal(logConfig{Level: "info"}, m.Current())
+}
+
+// TestWatchFile tests the options are reloaded when the watched file changes
+func (suite *OptionsMonitorSuite) TestWatchFile() {
+	// Arrange
+	path := filepath.Join(suite.T().TempDir(), "config.json")
+	suite.Require().NoError(os.WriteFile(path, []byte(`{"level": "info"}`), 0o600))
+
+	m, err := NewOptionsMonitor[logConfig]([]Source{JSONFile(path)}, WatchFile(path, 5*time.Millisecond))
+	suite.Require().NoError(err)
+	defer m.Close()
+
+	changed := make(chan logConfig, 1)
+	m.OnChange(func(cfg logConfig) {
+		changed <- cfg
+	})
+
+	// Act
+	suite.Require().NoError(os.WriteFile(path, []byte(`{"level": "warning"}`), 0o600))
+
+	// Assert
+	select {
+	case cfg := <-changed:
+		suite.Equal(logConfig{Level: "warning"}, cfg)
+		suite.Equal(cfg, m.Current())
+	case <-time.After(time.Second):
+		suite.Fail("options were not reloaded")
+	}
+}
+
+// TestWatchSignal tests the options are reloaded on the signal
+func (suite *OptionsMonitorSuite) TestWatchSignal() {
+	// Arrange
+	path := filepath.Join(suite.T().TempDir(), "config.json")
+	suite.Require().NoError(os.WriteFile(path, []byte(`{"level": "info"}`), 0o600))
+
+	m, err := NewOptionsMonitor[logConfig]([]Source{JSONFile(path)}, WatchSignal(syscall.SIGUSR2))
+	suite.Require().NoError(err)
+	defer m.Close()
+
+	changed := make(chan logConfig, 1)
+	m.OnChange(func(cfg logConfig) {
+		changed <- cfg
+	})
+	suite.Require().NoError(os.WriteFile(path, []byte(`{"level": "error"}`), 0o600))
+
+	// Act
+	deadline := time.After(time.Second)
+	for {
+		suite.Require().NoError(syscall.Kill(os.Getpid(), syscall.SIGUSR2))
+
+		// Assert
+		select {
+		case cfg := <-changed:
+			suite.Equal(logConfig{Level: "error"}, cfg)
+			return
+		case <-deadline:
+			suite.Fail("options were not reloaded")
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// TestConcurrentWatchers tests the reloads triggered by several watchers at once are serialized
+// and the last reload stores the latest value. Run with the race detector
+func (suite *OptionsMonitorSuite) TestConcurrentWatchers() {
+	// Arrange
+	path := filepath.Join(suite.T().TempDir(), "config.json")
+	suite.Require().NoError(os.WriteFile(path, []byte(`{"level": "info"}`), 0o600))
+
+	// the signals received before the watcher subscribes must not terminate the test
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGUSR2)
+	defer signal.Stop(sigs)
+
+	m, err := NewOptionsMonitor[logConfig](
+		[]Source{JSONFile(path)},
+		WatchFile(path, time.Millisecond),
+		WatchSignal(syscall.SIGUSR2),
+	)
+	suite.Require().NoError(err)
+	defer m.Close()
+
+	// Act
+	var wg sync.WaitGroup
+	for i := range 50 {
+		level := fmt.Sprintf(`{"level": "level%d"}`, i)
+		suite.Require().NoError(os.WriteFile(path, []byte(level), 0o600))
+		suite.Require().NoError(syscall.Kill(os.Getpid(), syscall.SIGUSR2))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = m.Reload()
+		}()
+		time.Sleep(time.Millisecond)
+	}
+	wg.Wait()
+
+	suite.Require().NoError(os.WriteFile(path, []byte(`{"level": "final"}`), 0o600))
+	err = m.Reload()
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(logConfig{Level: "final"}, m.Current())
+}
+
+// TestRegisterMonitor tests the OptionsMonitor is resolved from the Container
+func (suite *OptionsMonitorSuite) TestRegisterMonitor() {
+	// Arrange
+	c := di.NewContainer(RegisterMonitor[logConfig]([]Source{Map(map[string]string{"level": "info"})}))
+
+	// Act
+	m, err := di.GetService[*OptionsMonitor[logConfig]](c)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(logConfig{Level: "info"}, m.Current())
+	suite.NoError(c.Close())
+}
+
+// TestRegisterMonitorInvalid tests the invalid initial options fail the Container build
+func (suite *OptionsMonitorSuite) TestRegisterMonitorInvalid() {
+	// Act & Assert
+	suite.Panics(func() {
+		di.NewContainer(RegisterMonitor[logConfig]([]Source{Map(nil)}))
+	})
+}
+
+// TestOptionsMonitor tests the OptionsMonitor
+func TestOptionsMonitor(t *testing.T) {
+	suite.Run(t, new(OptionsMonitorSuite))
+}
@@ -0,0 +1,251 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package config
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Source is a configuration values source.
+//
+// Keys are the dot-separated lowercase paths of the bound struct fields, e.g. "db.host"
+type Source interface {
+	// Load loads the source values, called before every binding
+	Load() error
+
+	// Lookup returns the raw value for the provided key
+	Lookup(key string) (string, bool)
+}
+
+// envSource is the environment variables Source
+type envSource struct {
+	prefix string
+}
+
+// Env returns the Source looking the keys up in the environment variables.
+//
+// The key is uppercased, its dots and dashes are replaced with underscores
+// and the prefix is prepended to it with an underscore,
+// so the "db.host" key is looked up as the APP_DB_HOST variable for the "APP" prefix
+func Env(prefix string) Source {
+	return &envSource{
+		prefix: prefix,
+	}
+}
+
+// Load implements the Source interface
+func (src *envSource) Load() error {
+	return nil
+}
+
+// Lookup implements the Source interface
+func (src *envSource) Lookup(key string) (string, bool) {
+	name := strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+	if src.prefix != "" {
+		name = src.prefix + "_" + name
+	}
+
+	return os.LookupEnv(name)
+}
+
+// jsonSource is the JSON document Source
+type jsonSource struct {
+	// read reads the JSON document
+	read func() ([]byte, error)
+
+	// values is the decoded JSON document
+	values map[string]any
+}
+
+// JSON returns the Source looking the keys up in the provided JSON document
+func JSON(data []byte) Source {
+	return &jsonSource{
+		read: func() ([]byte, error) {
+			return data, nil
+		},
+	}
+}
+
+// JSONFile returns the Source looking the keys up in the JSON file.
+//
+// The file is read on every load
+func JSONFile(path string) Source {
+	return &jsonSource{
+		read: func() ([]byte, error) {
+			return os.ReadFile(path)
+		},
+	}
+}
+
+// Load implements the Source interface
+func (src *jsonSource) Load() error {
+	data, err := src.read()
+	if err != nil {
+		return fmt.Errorf("config: failed to read JSON source: %w", err)
+	}
+
+	var values map[string]any
+	if err = json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("config: failed to decode JSON source: %w", err)
+	}
+
+	src.values = values
+	return nil
+}
+
+// Lookup implements the Source interface
+func (src *jsonSource) Lookup(key string) (string, bool) {
+	var value any = src.values
+	for _, part := range strings.Split(key, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return "", false
+		}
+
+		if value, ok = lookupFold(obj, part); !ok {
+			return "", false
+		}
+	}
+
+	return formatJSONValue(value)
+}
+
+// lookupFold returns the JSON object value for the provided name
+// matched case-insensitively if there is no exact match
+func lookupFold(obj map[string]any, name string) (any, bool) {
+	if value, ok := obj[name]; ok {
+		return value, true
+	}
+
+	for k, value := range obj {
+		if strings.EqualFold(k, name) {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// formatJSONValue formats the decoded JSON scalar or array value as a raw value.
+// Array elements are joined with commas
+func formatJSONValue(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case []any:
+		elems := make([]string, 0, len(v))
+		for _, elem := range v {
+			s, ok := formatJSONValue(elem)
+			if !ok {
+				return "", false
+			}
+
+			elems = append(elems, s)
+		}
+
+		return strings.Join(elems, ","), true
+	default:
+		return "", false
+	}
+}
+
+// flagSource is the command-line flags Source
+type flagSource struct {
+	fs   *flag.FlagSet
+	args []string
+	set  map[string]string
+}
+
+// Flags returns the Source looking the keys up in the provided flag set
+// parsing the provided arguments if the set has not been parsed yet.
+//
+// Only the explicitly set flags are looked up, the flag name must be equal to the key
+func Flags(fs *flag.FlagSet, args []string) Source {
+	return &flagSource{
+		fs:   fs,
+		args: args,
+	}
+}
+
+// Load implements the Source interface
+func (src *flagSource) Load() error {
+	if !src.fs.Parsed() {
+		if err := src.fs.Parse(src.args); err != nil {
+			return fmt.Errorf("config: failed to parse flags: %w", err)
+		}
+	}
+
+	src.set = make(map[string]string)
+	src.fs.Visit(func(f *flag.Flag) {
+		src.set[f.Name] = f.Value.String()
+	})
+
+	return nil
+}
+
+// Lookup implements the Source interface
+func (src *flagSource) Lookup(key string) (string, bool) {
+	value, ok := src.set[key]
+	return value, ok
+}
+
+// mapSource is the in-memory Source
+type mapSource struct {
+	values map[string]string
+}
+
+// Map returns the Source looking the keys up in the provided map
+func Map(values map[string]string) Source {
+	return &mapSource{
+		values: values,
+	}
+}
+
+// Load implements the Source interface
+func (src *mapSource) Load() error {
+	return nil
+}
+
+// Lookup implements the Source interface
+func (src *mapSource) Lookup(key string) (string, bool) {
+	value, ok := src.values[key]
+	return value, ok
+}
+
+// sectionSource is the Source looking the keys up in the section of the other Source
+type sectionSource struct {
+	src     Source
+	section string
+}
+
+// Section returns the Source looking the keys up in the provided section of the source,
+// so the "host" key is looked up as the "payments.host" key for the "payments" section.
+//
+// Used to bind the named options from the different sections of the same source
+func Section(src Source, section string) Source {
+	return &sectionSource{
+		src:     src,
+		section: section,
+	}
+}
+
+// Load implements the Source interface
+func (src *sectionSource) Load() error {
+	return src.src.Load()
+}
+
+// Lookup implements the Source interface
+func (src *sectionSource) Lookup(key string) (string, bool) {
+	return src.src.Lookup(src.section + "." + key)
+}
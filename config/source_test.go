@@ -0,0 +1,90 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package config
+
+import (
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestEnv tests the Env source
+func TestEnv(t *testing.T) {
+	// Arrange
+	t.Setenv("APP_DB_HOST", "localhost")
+	src := Env("APP")
+
+	// Act
+	loadErr := src.Load()
+	value, ok := src.Lookup("db.host")
+	_, missing := src.Lookup("db.port")
+
+	// Assert
+	assert.NoError(t, loadErr)
+	assert.True(t, ok)
+	assert.Equal(t, "localhost", value)
+	assert.False(t, missing)
+}
+
+// TestJSONFile tests the JSONFile source
+func TestJSONFile(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "config.json")
+	data := `{"db": {"Host": "localhost", "port": 5432}, "tags": ["a", "b"], "debug": true}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	src := JSONFile(path)
+
+	// Act
+	loadErr := src.Load()
+	host, _ := src.Lookup("db.host")
+	port, _ := src.Lookup("db.port")
+	tags, _ := src.Lookup("tags")
+	debug, _ := src.Lookup("debug")
+	_, missing := src.Lookup("db")
+
+	// Assert
+	assert.NoError(t, loadErr)
+	assert.Equal(t, "localhost", host)
+	assert.Equal(t, "5432", port)
+	assert.Equal(t, "a,b", tags)
+	assert.Equal(t, "true", debug)
+	assert.False(t, missing)
+}
+
+// TestJSONFileMissing tests the JSONFile source load error
+func TestJSONFileMissing(t *testing.T) {
+	// Arrange
+	src := JSONFile(filepath.Join(t.TempDir(), "missing.json"))
+
+	// Act
+	err := src.Load()
+
+	// Assert
+	assert.Error(t, err)
+}
+
+// TestFlags tests the Flags source
+func TestFlags(t *testing.T) {
+	// Arrange
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("db.host", "default", "")
+	fs.Int("db.port", 5432, "")
+	src := Flags(fs, []string{"-db.host", "localhost"})
+
+	// Act
+	loadErr := src.Load()
+	host, ok := src.Lookup("db.host")
+	_, portSet := src.Lookup("db.port")
+
+	// Assert
+	assert.NoError(t, loadErr)
+	assert.True(t, ok)
+	assert.Equal(t, "localhost", host)
+	assert.False(t, portSet)
+}
@@ -5,10 +5,12 @@
 package di
 
 import (
-	"container/list"
+	"context"
 	"iter"
 	"reflect"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // serviceAccessor is a struct used to get the service instance
@@ -19,8 +21,8 @@ type serviceAccessor struct {
 	// cont is the accessor's Container
 	cont *Container
 
-	// once protects the instance from creating multiple times
-	once sync.Once
+	// mu protects the instance from creating multiple times
+	mu sync.Mutex
 
 	// factory is the accessor's service factory
 	// nil if the service was added with the instance
@@ -35,6 +37,34 @@ type serviceAccessor struct {
 	// nil if the service instance has not been requested yet
 	// or an error did not occur while creating the instance
 	err error
+
+	// boxed is the accessor's service instance as an interface value,
+	// boxed once so the cached instance is returned without allocations
+	boxed any
+
+	// ready is true after the instance is created successfully and boxed
+	ready atomic.Bool
+
+	// resolved is true after the service is requested from the Container
+	resolved atomic.Bool
+
+	// builtin is true for the services added to every Container by default
+	builtin bool
+
+	// policy is the accessor's FailurePolicy
+	policy FailurePolicy
+
+	// onStart are the accessor's service start hooks
+	onStart []lifecycleHook
+
+	// onStop are the accessor's service stop hooks
+	onStop []lifecycleHook
+
+	// validateOnBuild is true if the service is created and validated when the Container is built
+	validateOnBuild bool
+
+	// lifetime is the accessor's service Lifetime
+	lifetime Lifetime
 }
 
 // newServiceAccessor creates a new serviceAccessor
@@ -52,71 +82,133 @@ func newServiceAccessor(
 	}
 }
 
-// createInstance creates the service instance once
-func (accessor *serviceAccessor) createInstance() {
-	if accessor.instance == nil {
-		var instance reflect.Value
-		deps, err := accessor.cont.resolveFactoryDeps(accessor.factory)
-		if err == nil {
-			instance, err = accessor.factory.Call(deps...)
+// applyOptions applies the provided service options to the accessor
+func (accessor *serviceAccessor) applyOptions(svcOpts []ServiceOption) {
+	for _, opt := range svcOpts {
+		opt.applyService(accessor)
+	}
+}
+
+// callFactory calls the accessor's factory with the resolved dependencies
+func (accessor *serviceAccessor) callFactory(ctx context.Context) (reflect.Value, error) {
+	deps, err := accessor.cont.resolveFactoryDeps(ctx, accessor.factory)
+	if err != nil {
+		return reflect.Zero(accessor.id.Type), err
+	}
+
+	if err = ctx.Err(); err != nil {
+		return reflect.Zero(accessor.id.Type), err
+	}
+
+	instance, err := accessor.factory.Call(deps...)
+	if err != nil {
+		return reflect.Zero(accessor.id.Type), err
+	}
+
+	return instance, nil
+}
+
+// createInstance creates the service instance
+// calling the factory up to the policy attempts count
+func (accessor *serviceAccessor) createInstance(ctx context.Context) (reflect.Value, error) {
+	backoff := accessor.policy.backoff
+	for attempt := 1; ; attempt++ {
+		instance, err := accessor.callFactory(ctx)
+		if err == nil || attempt >= accessor.policy.attempts() {
+			return instance, err
 		}
 
-		if err != nil {
-			accessor.err = err
-			zero := reflect.Zero(accessor.id.Type)
-			accessor.instance = &zero
-		} else {
-			accessor.instance = &instance
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return instance, ctx.Err()
+		case <-timer.C:
 		}
+
+		backoff *= 2
 	}
 }
 
 // Instance returns the accessor service instance
-func (accessor *serviceAccessor) Instance() (reflect.Value, error) {
-	accessor.once.Do(accessor.createInstance)
+// creating it with the provided context if it has not been created yet.
+//
+// The error caused by the context cancellation is never cached
+func (accessor *serviceAccessor) Instance(ctx context.Context) (reflect.Value, error) {
+	accessor.mu.Lock()
+	defer accessor.mu.Unlock()
+
+	if accessor.instance == nil {
+		instance, err := accessor.createInstance(ctx)
+		if err != nil && (accessor.policy.retry || ctx.Err() != nil) {
+			return instance, err
+		}
+
+		accessor.instance, accessor.err = &instance, err
+		if err == nil && accessor.cont != nil {
+			accessor.cont.trackCreated(accessor, instance)
+		}
+	}
+
+	if accessor.err == nil && !accessor.ready.Load() && accessor.instance.IsValid() {
+		accessor.boxed = accessor.instance.Interface()
+		accessor.ready.Store(true)
+	}
+
 	return *accessor.instance, accessor.err
 }
 
-// serviceAccessorsList is a singly linked list of service accessors
+// markResolved marks the service requested from the Container
+func (accessor *serviceAccessor) markResolved() {
+	if !accessor.resolved.Load() {
+		accessor.resolved.Store(true)
+	}
+}
+
+// cached returns the boxed service instance if it has been created successfully.
+//
+// Never locks, creates the instance or allocates
+func (accessor *serviceAccessor) cached() (any, bool) {
+	if !accessor.ready.Load() {
+		return nil, false
+	}
+
+	return accessor.boxed, true
+}
+
+// serviceAccessorsList is a list of service accessors in the registration order
 type serviceAccessorsList struct {
-	// internal is the list.List instance
-	internal *list.List
+	// items are the list accessors
+	items []*serviceAccessor
 }
 
 // newServiceAccessorsList creates a new serviceAccessorsList
 func newServiceAccessorsList(accessors ...*serviceAccessor) *serviceAccessorsList {
-	l := &serviceAccessorsList{
-		internal: list.New(),
+	return &serviceAccessorsList{
+		items: accessors,
 	}
-
-	for _, accessor := range accessors {
-		l.Append(accessor)
-	}
-
-	return l
 }
 
 // Append adds an element to the end of the list
 func (list *serviceAccessorsList) Append(accessor *serviceAccessor) {
-	list.internal.PushBack(accessor)
+	list.items = append(list.items, accessor)
 }
 
 // Last returns the last element of the list
 func (list *serviceAccessorsList) Last() *serviceAccessor {
-	accessor, _ := list.internal.Back().Value.(*serviceAccessor)
-	return accessor
+	return list.items[len(list.items)-1]
 }
 
 // Len returns the length of the list
 func (list *serviceAccessorsList) Len() int {
-	return list.internal.Len()
+	return len(list.items)
 }
 
 // Iter returns an iterator over the elements of the list
 func (list *serviceAccessorsList) Iter() iter.Seq2[int, *serviceAccessor] {
 	return func(yield func(int, *serviceAccessor) bool) {
-		for i, el := 0, list.internal.Front(); el != nil; i, el = i+1, el.Next() {
-			if !yield(i, el.Value.(*serviceAccessor)) {
+		for i, accessor := range list.items {
+			if !yield(i, accessor) {
 				return
 			}
 		}
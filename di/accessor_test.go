@@ -5,12 +5,14 @@
 package di
 
 import (
+	"context"
 	"errors"
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"reflect"
 	"testing"
+	"time"
 )
 
 // ServiceAccessorInstanceSuite is the suite for testing the serviceAccessor.Instance method
@@ -32,8 +34,8 @@ func (suite *ServiceAccessorInstanceSuite) TestInstance() {
 	accessor := newServiceAccessor(id, nil, nil, &inst)
 
 	// Act
-	val1, err1 := accessor.Instance()
-	val2, err2 := accessor.Instance()
+	val1, err1 := accessor.Instance(context.Background())
+	val2, err2 := accessor.Instance(context.Background())
 
 	// Assert
 	suite.Equal(inst, val1)
@@ -62,8 +64,8 @@ func (suite *ServiceAccessorInstanceSuite) TestFactory() {
 	accessor := newServiceAccessor(id, nil, f, nil)
 
 	// Act
-	val1, err1 := accessor.Instance()
-	val2, err2 := accessor.Instance()
+	val1, err1 := accessor.Instance(context.Background())
+	val2, err2 := accessor.Instance(context.Background())
 
 	// Assert
 	suite.Equal(1, timesCalled)
@@ -92,8 +94,8 @@ func (suite *ServiceAccessorInstanceSuite) TestFactoryError() {
 	accessor := newServiceAccessor(id, nil, f, nil)
 
 	// Act
-	val1, err1 := accessor.Instance()
-	val2, err2 := accessor.Instance()
+	val1, err1 := accessor.Instance(context.Background())
+	val2, err2 := accessor.Instance(context.Background())
 
 	// Assert
 	suite.Equal(1, timesCalled)
@@ -108,6 +110,109 @@ func TestServiceAccessor_Instance(t *testing.T) {
 	suite.Run(t, new(ServiceAccessorInstanceSuite))
 }
 
+// ServiceAccessorFailurePolicySuite is the suite for testing the serviceAccessor.Instance method
+// with the different failure policies
+type ServiceAccessorFailurePolicySuite struct {
+	suite.Suite
+}
+
+// newFlakyAccessor creates a new serviceAccessor with the factory
+// failing the first failures times
+func (suite *ServiceAccessorFailurePolicySuite) newFlakyAccessor(
+	failures int,
+	policy FailurePolicy,
+) (*serviceAccessor, *int) {
+	id := serviceIdentifier{
+		Type: reflect.TypeFor[string](),
+	}
+
+	timesCalled := 0
+	f := newServiceFactory(func() (string, error) {
+		timesCalled++
+		if timesCalled <= failures {
+			return "", errors.ErrUnsupported
+		}
+
+		return "instance", nil
+	})
+
+	accessor := newServiceAccessor(id, nil, f, nil)
+	accessor.applyOptions([]ServiceOption{WithFailurePolicy(policy)})
+
+	return accessor, &timesCalled
+}
+
+// TestCacheFailure tests the error is cached
+func (suite *ServiceAccessorFailurePolicySuite) TestCacheFailure() {
+	// Arrange
+	accessor, timesCalled := suite.newFlakyAccessor(1, CacheFailure())
+
+	// Act
+	_, err1 := accessor.Instance(context.Background())
+	_, err2 := accessor.Instance(context.Background())
+
+	// Assert
+	suite.Equal(1, *timesCalled)
+	suite.ErrorIs(err1, errors.ErrUnsupported)
+	suite.ErrorIs(err2, errors.ErrUnsupported)
+}
+
+// TestRetryOnResolve tests the factory is called again on the next resolve
+func (suite *ServiceAccessorFailurePolicySuite) TestRetryOnResolve() {
+	// Arrange
+	accessor, timesCalled := suite.newFlakyAccessor(1, RetryOnResolve())
+
+	// Act
+	_, err1 := accessor.Instance(context.Background())
+	val2, err2 := accessor.Instance(context.Background())
+	val3, err3 := accessor.Instance(context.Background())
+
+	// Assert
+	suite.Equal(2, *timesCalled)
+	suite.ErrorIs(err1, errors.ErrUnsupported)
+	suite.NoError(err2)
+	suite.NoError(err3)
+	suite.Equal("instance", val2.Interface())
+	suite.Equal(val2, val3)
+	suite.Nil(accessor.err)
+}
+
+// TestRetryWithBackoff tests the factory is retried within a single resolve
+func (suite *ServiceAccessorFailurePolicySuite) TestRetryWithBackoff() {
+	// Arrange
+	accessor, timesCalled := suite.newFlakyAccessor(2, RetryWithBackoff(3, time.Millisecond))
+
+	// Act
+	val, err := accessor.Instance(context.Background())
+
+	// Assert
+	suite.Equal(3, *timesCalled)
+	suite.NoError(err)
+	suite.Equal("instance", val.Interface())
+}
+
+// TestRetryWithBackoffExhausted tests the error is returned after the max attempts
+// and the factory is called again on the next resolve
+func (suite *ServiceAccessorFailurePolicySuite) TestRetryWithBackoffExhausted() {
+	// Arrange
+	accessor, timesCalled := suite.newFlakyAccessor(3, RetryWithBackoff(2, time.Millisecond))
+
+	// Act
+	_, err1 := accessor.Instance(context.Background())
+	val2, err2 := accessor.Instance(context.Background())
+
+	// Assert
+	suite.Equal(4, *timesCalled)
+	suite.ErrorIs(err1, errors.ErrUnsupported)
+	suite.NoError(err2)
+	suite.Equal("instance", val2.Interface())
+}
+
+// TestServiceAccessor_FailurePolicy tests the serviceAccessor failure policies
+func TestServiceAccessor_FailurePolicy(t *testing.T) {
+	suite.Run(t, new(ServiceAccessorFailurePolicySuite))
+}
+
 // TestServiceAccessorsList_Append tests the serviceAccessorsList.Append method
 func TestServiceAccessorsList_Append(t *testing.T) {
 	// Arrange
@@ -0,0 +1,199 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+// allocService is the service used in the allocation tests
+type allocService struct {
+	name string
+}
+
+// allocConfig is the value type service used in the allocation tests
+type allocConfig struct {
+	host string
+	port int
+}
+
+// newAllocContainer creates the Container used in the allocation tests
+func newAllocContainer() *Container {
+	return NewContainer(
+		WithFactory(func() *allocService {
+			return &allocService{name: "test"}
+		}),
+		WithFactory(func() allocConfig {
+			return allocConfig{host: "localhost", port: 8080}
+		}),
+		WithKeyedFactory("key", func() *allocService {
+			return &allocService{name: "keyed"}
+		}),
+		WithValue("value"),
+		WithFactory(func() *scopedService {
+			return &scopedService{}
+		}, WithLifetime(Scoped)),
+	)
+}
+
+// AllocsSuite is the suite for testing the allocations of the service resolution
+type AllocsSuite struct {
+	suite.Suite
+	c *Container
+}
+
+// SetupTest creates the Container and resolves the services once
+func (suite *AllocsSuite) SetupTest() {
+	suite.c = newAllocContainer()
+
+	MustGetService[*allocService](suite.c)
+	MustGetService[allocConfig](suite.c)
+	MustGetKeyedService[*allocService](suite.c, "key")
+	MustGetService[string](suite.c)
+}
+
+// TestPointerSingleton tests that the created pointer Singleton is resolved without allocations
+func (suite *AllocsSuite) TestPointerSingleton() {
+	// Act
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = GetService[*allocService](suite.c)
+	})
+
+	// Assert
+	suite.Zero(allocs)
+}
+
+// TestValueSingleton tests that the created value type Singleton is resolved without allocations
+func (suite *AllocsSuite) TestValueSingleton() {
+	// Act
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = GetService[allocConfig](suite.c)
+	})
+
+	// Assert
+	suite.Zero(allocs)
+}
+
+// TestKeyedSingleton tests that the created keyed Singleton is resolved without allocations
+func (suite *AllocsSuite) TestKeyedSingleton() {
+	// Act
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = GetKeyedService[*allocService](suite.c, "key")
+	})
+
+	// Assert
+	suite.Zero(allocs)
+}
+
+// TestInstance tests that the service added with an instance is resolved without allocations
+func (suite *AllocsSuite) TestInstance() {
+	// Act
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = MustGetService[string](suite.c)
+	})
+
+	// Assert
+	suite.Zero(allocs)
+}
+
+// TestScopeSingleton tests that the Singleton is resolved from a scope without allocations
+func (suite *AllocsSuite) TestScopeSingleton() {
+	// Arrange
+	scope := suite.c.NewScope()
+	defer scope.Close()
+
+	// Act
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = GetService[*allocService](scope)
+	})
+
+	// Assert
+	suite.Zero(allocs)
+}
+
+// TestScopedFallback tests that the Scoped services are still resolved per scope
+func (suite *AllocsSuite) TestScopedFallback() {
+	// Arrange
+	first, second := suite.c.NewScope(), suite.c.NewScope()
+	defer first.Close()
+	defer second.Close()
+
+	// Act
+	res1 := MustGetService[*scopedService](first)
+	res2 := MustGetService[*scopedService](second)
+
+	// Assert
+	suite.NotSame(res1, res2)
+	suite.Same(res1, MustGetService[*scopedService](first))
+}
+
+// TestAllocs runs the AllocsSuite
+func TestAllocs(t *testing.T) {
+	suite.Run(t, new(AllocsSuite))
+}
+
+// BenchmarkGetServiceSingleton benchmarks resolving the created pointer Singleton
+func BenchmarkGetServiceSingleton(b *testing.B) {
+	c := newAllocContainer()
+	MustGetService[*allocService](c)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetService[*allocService](c)
+	}
+}
+
+// BenchmarkGetServiceValue benchmarks resolving the created value type Singleton
+func BenchmarkGetServiceValue(b *testing.B) {
+	c := newAllocContainer()
+	MustGetService[allocConfig](c)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetService[allocConfig](c)
+	}
+}
+
+// BenchmarkGetKeyedService benchmarks resolving the created keyed Singleton
+func BenchmarkGetKeyedService(b *testing.B) {
+	c := newAllocContainer()
+	MustGetKeyedService[*allocService](c, "key")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetKeyedService[*allocService](c, "key")
+	}
+}
+
+// BenchmarkGetServiceParallel benchmarks resolving the created Singleton concurrently
+func BenchmarkGetServiceParallel(b *testing.B) {
+	c := newAllocContainer()
+	MustGetService[*allocService](c)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = GetService[*allocService](c)
+		}
+	})
+}
+
+// BenchmarkGetServiceScoped benchmarks resolving the Scoped service from a new scope
+func BenchmarkGetServiceScoped(b *testing.B) {
+	c := newAllocContainer()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scope := c.NewScope()
+		_, _ = GetService[*scopedService](scope)
+		_ = scope.Close()
+	}
+}
@@ -0,0 +1,64 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"log"
+	"runtime"
+)
+
+// Builder collects the Container registrations and builds the Container.
+//
+// The Builder is mutable and must not be used concurrently.
+// No services can be registered in the built Container,
+// so it is safe to resolve the services from many goroutines
+type Builder struct {
+	// opts are the registered options in the registration order
+	opts []Option
+}
+
+// NewBuilder creates a new Builder with the provided options
+func NewBuilder(opts ...Option) *Builder {
+	b := &Builder{}
+	return b.Add(opts...)
+}
+
+// Add adds the options to the Builder
+func (b *Builder) Add(opts ...Option) *Builder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Build builds a new Container with the registered options
+// and validates the services marked with the ValidateOnBuild option.
+//
+// Every Build call creates a new Container with its own service instances.
+// Returns the ValidationError if any of the validated services is invalid
+func (b *Builder) Build() (*Container, error) {
+	c := &Container{
+		accessors:        make(serviceAccessors),
+		startConcurrency: runtime.GOMAXPROCS(0),
+	}
+
+	c.applyOptions(b.opts)
+
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// MustBuild builds a new Container like the Build method.
+//
+// Panics with the ValidationError message if any of the validated services is invalid
+func (b *Builder) MustBuild() *Container {
+	c, err := b.Build()
+	if err != nil {
+		log.Panic(err)
+	}
+
+	return c
+}
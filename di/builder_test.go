@@ -0,0 +1,236 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// goroutines is the number of the goroutines resolving the services concurrently
+const goroutines = 500
+
+// builderService is the service used in the Builder tests
+type builderService struct {
+	name string
+}
+
+// invalidBuilderService is the service failing the validation
+type invalidBuilderService struct{}
+
+// Validate implements the Validator interface
+func (*invalidBuilderService) Validate() error {
+	return errors.New("invalid service")
+}
+
+// BuilderSuite is the suite for testing the Builder
+type BuilderSuite struct {
+	suite.Suite
+}
+
+// TestBuild tests that the built Container resolves the registered services
+func (suite *BuilderSuite) TestBuild() {
+	// Arrange
+	b := NewBuilder(WithValue(&builderService{name: "first"}))
+	b.Add(WithValue(&builderService{name: "second"}))
+
+	// Act
+	c, err := b.Build()
+
+	// Assert
+	suite.Require().NoError(err)
+	suite.Equal("second", MustGetService[*builderService](c).name)
+	suite.Len(MustGetService[[]*builderService](c), 2)
+	suite.Same(c, MustGetService[*Container](c))
+}
+
+// TestBuildTwice tests that every Build call creates a new Container with its own instances
+func (suite *BuilderSuite) TestBuildTwice() {
+	// Arrange
+	b := NewBuilder(WithFactory(func() *builderService {
+		return &builderService{}
+	}))
+
+	// Act
+	c1, err1 := b.Build()
+	c2, err2 := b.Build()
+
+	// Assert
+	suite.Require().NoError(err1)
+	suite.Require().NoError(err2)
+	suite.NotSame(MustGetService[*builderService](c1), MustGetService[*builderService](c2))
+}
+
+// TestAddAfterBuild tests that the options added after the Build do not change the built Container
+func (suite *BuilderSuite) TestAddAfterBuild() {
+	// Arrange
+	b := NewBuilder()
+	c, err := b.Build()
+	suite.Require().NoError(err)
+
+	// Act
+	b.Add(WithValue(&builderService{}))
+
+	// Assert
+	_, err = GetService[*builderService](c)
+	suite.ErrorIs(err, ErrServiceNotFound)
+}
+
+// TestValidation tests that the Build returns the ValidationError
+func (suite *BuilderSuite) TestValidation() {
+	// Arrange
+	b := NewBuilder(WithValue(&invalidBuilderService{}, ValidateOnBuild()))
+
+	// Act
+	c, err := b.Build()
+
+	// Assert
+	suite.Nil(c)
+	var validationErr *ValidationError
+	if suite.ErrorAs(err, &validationErr) {
+		suite.Len(validationErr.Errs, 1)
+	}
+
+	suite.Panics(func() {
+		b.MustBuild()
+	})
+}
+
+// TestBuilder runs the BuilderSuite
+func TestBuilder(t *testing.T) {
+	suite.Run(t, new(BuilderSuite))
+}
+
+// ConcurrencySuite is the suite for testing the concurrent service resolution.
+// Run with the race detector
+type ConcurrencySuite struct {
+	suite.Suite
+	calls atomic.Int32
+	c     *Container
+}
+
+// SetupTest builds the Container
+func (suite *ConcurrencySuite) SetupTest() {
+	suite.calls.Store(0)
+	suite.c = NewBuilder(
+		WithFactory(func() *builderService {
+			suite.calls.Add(1)
+			return &builderService{name: "singleton"}
+		}),
+		WithFactory(func(svc *builderService) *scopedService {
+			return &scopedService{}
+		}, WithLifetime(Scoped)),
+		WithKeyedFactory("transient", func() *builderService {
+			return &builderService{name: "transient"}
+		}, WithLifetime(Transient)),
+	).MustBuild()
+}
+
+// run runs the function in the goroutines started at the same time
+func (suite *ConcurrencySuite) run(fn func(i int)) {
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			fn(i)
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+}
+
+// TestSingleton tests that the Singleton is created once for all the goroutines
+func (suite *ConcurrencySuite) TestSingleton() {
+	// Arrange
+	results := make([]*builderService, goroutines)
+	errs := make([]error, goroutines)
+
+	// Act
+	suite.run(func(i int) {
+		results[i], errs[i] = GetService[*builderService](suite.c)
+	})
+
+	// Assert
+	suite.Equal(int32(1), suite.calls.Load())
+	for i := range goroutines {
+		suite.Require().NoError(errs[i])
+		suite.Same(results[0], results[i])
+	}
+}
+
+// TestScopes tests that the scopes created concurrently share the Singleton
+// and create their own Scoped services
+func (suite *ConcurrencySuite) TestScopes() {
+	// Arrange
+	scoped := make([]*scopedService, goroutines)
+	errs := make([]error, goroutines)
+
+	// Act
+	suite.run(func(i int) {
+		scope := suite.c.NewScope()
+		defer scope.Close()
+
+		scoped[i], errs[i] = GetService[*scopedService](scope)
+	})
+
+	// Assert
+	suite.Equal(int32(1), suite.calls.Load())
+	seen := make(map[*scopedService]bool, goroutines)
+	for i := range goroutines {
+		suite.Require().NoError(errs[i])
+		suite.False(seen[scoped[i]])
+		seen[scoped[i]] = true
+	}
+}
+
+// TestSharedScope tests that the Scoped service is created once for the scope shared by the goroutines
+func (suite *ConcurrencySuite) TestSharedScope() {
+	// Arrange
+	scope := suite.c.NewScope()
+	defer scope.Close()
+
+	results := make([]*scopedService, goroutines)
+
+	// Act
+	suite.run(func(i int) {
+		results[i], _ = GetService[*scopedService](scope)
+	})
+
+	// Assert
+	for i := range goroutines {
+		suite.Same(results[0], results[i])
+	}
+}
+
+// TestTransient tests that the Transient services are created for every goroutine
+func (suite *ConcurrencySuite) TestTransient() {
+	// Arrange
+	results := make([]*builderService, goroutines)
+
+	// Act
+	suite.run(func(i int) {
+		results[i], _ = GetKeyedService[*builderService](suite.c, "transient")
+	})
+
+	// Assert
+	seen := make(map[*builderService]bool, goroutines)
+	for i := range goroutines {
+		suite.NotNil(results[i])
+		suite.False(seen[results[i]])
+		seen[results[i]] = true
+	}
+}
+
+// TestConcurrency runs the ConcurrencySuite
+func TestConcurrency(t *testing.T) {
+	suite.Run(t, new(ConcurrencySuite))
+}
@@ -0,0 +1,79 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"os"
+	"reflect"
+)
+
+// whenOption applies the options if the condition holds
+type whenOption struct {
+	cond func() bool
+	opts []Option
+}
+
+// apply applies the Option
+func (opt *whenOption) apply(c *Container) {
+	if !opt.cond() {
+		return
+	}
+
+	for _, o := range opt.opts {
+		o.apply(c)
+	}
+}
+
+// When applies the provided options only if the cond returns true.
+//
+// The cond is called when the Container is built, not when the option is created
+func When(cond func() bool, opts ...Option) Option {
+	return &whenOption{
+		cond: cond,
+		opts: opts,
+	}
+}
+
+// WhenEnv applies the provided options only if the environment variable
+// with the provided key equals the value when the Container is built
+func WhenEnv(key, value string, opts ...Option) Option {
+	return When(func() bool {
+		return os.Getenv(key) == value
+	}, opts...)
+}
+
+// whenMissingOption applies the options if no service of the type is added to the Container
+type whenMissingOption struct {
+	id   serviceIdentifier
+	opts []Option
+}
+
+// apply applies the Option
+func (opt *whenMissingOption) apply(c *Container) {
+	c.deferred = append(c.deferred, opt)
+}
+
+// applyDeferred applies the options if the service is still missing
+func (opt *whenMissingOption) applyDeferred(c *Container) {
+	if _, ok := c.lookupAccessors(opt.id); ok {
+		return
+	}
+
+	for _, o := range opt.opts {
+		o.apply(c)
+	}
+}
+
+// WhenMissing applies the provided options only if no service of type T is added to the Container,
+// e.g. to add the default implementation of the interface.
+//
+// WhenMissing options are evaluated last, after all the other options are applied,
+// in the order they are added. The scopes also look for the services in their parent Containers
+func WhenMissing[T any](opts ...Option) Option {
+	return &whenMissingOption{
+		id:   newServiceIdentifier(reflect.TypeFor[T](), nil),
+		opts: opts,
+	}
+}
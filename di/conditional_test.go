@@ -0,0 +1,117 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+// cache is the interface with the implementations swapped by the conditional options
+type cache interface {
+	Name() string
+}
+
+// namedCache implements the cache interface
+type namedCache string
+
+// Name implements the cache interface
+func (c namedCache) Name() string {
+	return string(c)
+}
+
+// ConditionalSuite is the suite for testing the conditional options
+type ConditionalSuite struct {
+	suite.Suite
+}
+
+// TestWhen tests that the options are applied only if the condition holds
+func (suite *ConditionalSuite) TestWhen() {
+	// Arrange
+	calls := 0
+	opt := When(func() bool {
+		calls++
+		return calls == 1
+	}, WithValue[cache](namedCache("memory")))
+
+	// Act
+	c1 := NewContainer(opt)
+	c2 := NewContainer(opt)
+
+	// Assert
+	suite.Equal("memory", MustGetService[cache](c1).Name())
+	_, err := GetService[cache](c2)
+	suite.ErrorIs(err, ErrServiceNotFound)
+}
+
+// TestWhenEnv tests that the options are applied only if the environment variable equals the value
+func (suite *ConditionalSuite) TestWhenEnv() {
+	// Arrange
+	suite.T().Setenv("APP_ENV", "prod")
+
+	// Act
+	c := NewContainer(
+		WhenEnv("APP_ENV", "dev", WithValue[cache](namedCache("memory"))),
+		WhenEnv("APP_ENV", "prod", WithValue[cache](namedCache("redis"))),
+	)
+
+	// Assert
+	res, err := GetService[[]cache](c)
+	suite.NoError(err)
+	if suite.Len(res, 1) {
+		suite.Equal("redis", res[0].Name())
+	}
+}
+
+// TestWhenMissing tests that the options are applied only if the service is not added
+func (suite *ConditionalSuite) TestWhenMissing() {
+	// Arrange
+	fallback := WhenMissing[cache](WithValue[cache](namedCache("memory")))
+
+	// Act
+	withDefault := NewContainer(fallback)
+	withCache := NewContainer(fallback, WithValue[cache](namedCache("redis")))
+
+	// Assert
+	suite.Equal("memory", MustGetService[cache](withDefault).Name())
+	suite.Len(MustGetService[[]cache](withCache), 1)
+	suite.Equal("redis", MustGetService[cache](withCache).Name())
+}
+
+// TestWhenMissingOrder tests that the WhenMissing options are evaluated last in the order they are added
+func (suite *ConditionalSuite) TestWhenMissingOrder() {
+	// Act
+	c := NewContainer(
+		WhenMissing[cache](WithValue[cache](namedCache("first"))),
+		WhenMissing[cache](WithValue[cache](namedCache("second"))),
+		When(func() bool { return true },
+			WhenMissing[string](WithValue("nested")),
+		),
+		WhenEnv("GONET_TEST_MISSING_ENV", "", WithValue(1)),
+	)
+
+	// Assert
+	suite.Equal([]cache{namedCache("first")}, MustGetService[[]cache](c))
+	suite.Equal("nested", MustGetService[string](c))
+	suite.Equal(1, MustGetService[int](c))
+}
+
+// TestWhenMissingScope tests that the scope WhenMissing options find the parent services
+func (suite *ConditionalSuite) TestWhenMissingScope() {
+	// Arrange
+	c := NewContainer(WithValue[cache](namedCache("redis")))
+
+	// Act
+	scope := c.NewScope(WhenMissing[cache](WithValue[cache](namedCache("memory"))))
+	defer scope.Close()
+
+	// Assert
+	suite.Equal("redis", MustGetService[cache](scope).Name())
+}
+
+// TestConditional runs the ConditionalSuite
+func TestConditional(t *testing.T) {
+	suite.Run(t, new(ConditionalSuite))
+}
@@ -5,10 +5,13 @@
 package di
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"reflect"
+	"sync"
 )
 
 var (
@@ -16,6 +19,10 @@ var (
 	ErrServiceNotFound = errors.New("di: requested service not found")
 )
 
+// contextType is the context.Context type
+// which is resolved to the resolving context
+var contextType = reflect.TypeFor[context.Context]()
+
 // DependencyError is a custom error type for dependency injection failures.
 type DependencyError struct {
 	// DependencyType is the type of the dependency that failed to be created
@@ -53,6 +60,7 @@ type serviceFactoryOption struct {
 	typ     reflect.Type
 	key     *string
 	factory any
+	svcOpts []ServiceOption
 }
 
 // apply applies the Option
@@ -66,15 +74,17 @@ func (opt *serviceFactoryOption) apply(c *Container) {
 	}
 
 	accessor := newServiceAccessor(id, c, f, nil)
+	accessor.applyOptions(opt.svcOpts)
 	c.appendAccessor(id, accessor)
 }
 
 // withServiceFactory returns a new instance of serviceFactoryOption
-func withServiceFactory[T any](key *string, factory any) Option {
+func withServiceFactory[T any](key *string, factory any, svcOpts []ServiceOption) Option {
 	return &serviceFactoryOption{
 		typ:     reflect.TypeFor[T](),
 		key:     key,
 		factory: factory,
+		svcOpts: svcOpts,
 	}
 }
 
@@ -83,6 +93,7 @@ type serviceInstanceOption struct {
 	typ      reflect.Type
 	key      *string
 	instance any
+	svcOpts  []ServiceOption
 }
 
 // apply applies the Option
@@ -97,53 +108,90 @@ func (opt *serviceInstanceOption) apply(c *Container) {
 	}
 
 	accessor := newServiceAccessor(id, c, nil, &instVal)
+	accessor.applyOptions(opt.svcOpts)
 	c.appendAccessor(id, accessor)
 }
 
 // withServiceInstance adds a new keyed service with an instance to the Container
-func withServiceInstance[T any](key *string, instance any) Option {
+func withServiceInstance[T any](key *string, instance any, svcOpts []ServiceOption) Option {
 	return &serviceInstanceOption{
 		typ:      reflect.TypeFor[T](),
 		key:      key,
 		instance: instance,
+		svcOpts:  svcOpts,
 	}
 }
 
 // withServiceKey adds service to the Container with the provided key
-func withServiceKey[T any](key *string, factoryOrInstance any) Option {
+func withServiceKey[T any](key *string, factoryOrInstance any, svcOpts []ServiceOption) Option {
 	if reflect.TypeOf(factoryOrInstance).Kind() == reflect.Func {
-		return withServiceFactory[T](key, factoryOrInstance)
+		return withServiceFactory[T](key, factoryOrInstance, svcOpts)
 	} else {
-		return withServiceInstance[T](key, factoryOrInstance)
+		return withServiceInstance[T](key, factoryOrInstance, svcOpts)
 	}
 }
 
 // WithService adds a new service to the Container with the provided factory or instance
-func WithService[T any](factoryOrInstance any) Option {
-	return withServiceKey[T](nil, factoryOrInstance)
+func WithService[T any](factoryOrInstance any, svcOpts ...ServiceOption) Option {
+	return withServiceKey[T](nil, factoryOrInstance, svcOpts)
 }
 
 // WithKeyedService adds a new keyed service to the Container with the provided factory or instance
-func WithKeyedService[T any](key string, factoryOrInstance any) Option {
-	return withServiceKey[T](&key, factoryOrInstance)
+func WithKeyedService[T any](key string, factoryOrInstance any, svcOpts ...ServiceOption) Option {
+	return withServiceKey[T](&key, factoryOrInstance, svcOpts)
+}
+
+// overrideOption replaces the services registered before with the new one
+type overrideOption struct {
+	id  serviceIdentifier
+	opt Option
+}
+
+// apply applies the Option
+func (opt *overrideOption) apply(c *Container) {
+	delete(c.accessors, opt.id)
+
+	registrations := c.registrations[:0]
+	for _, accessor := range c.registrations {
+		if accessor.id != opt.id {
+			registrations = append(registrations, accessor)
+		}
+	}
+	clear(c.registrations[len(registrations):])
+	c.registrations = registrations
+
+	opt.opt.apply(c)
+}
+
+// WithOverride replaces all the services of type T added to the Container before
+// with the provided value, e.g. with the fake in the tests.
+// The services of type T added after the WithOverride option are not replaced
+func WithOverride[T any](value T, svcOpts ...ServiceOption) Option {
+	return &overrideOption{
+		id:  newServiceIdentifier(reflect.TypeFor[T](), nil),
+		opt: withServiceInstance[T](nil, value, svcOpts),
+	}
 }
 
 // WithValue adds a new value to the Container with the provided value
-// Same as the WithService[T](value), but typed
-func WithValue[T any](value T) Option {
-	return withServiceKey[T](nil, value)
+// Same as the WithService[T](value), but typed.
+// The function values are added as the instances, not as the factories
+func WithValue[T any](value T, svcOpts ...ServiceOption) Option {
+	return withServiceInstance[T](nil, value, svcOpts)
 }
 
 // WithKeyedValue adds a new keyed value to the Container with the provided value
-// Same as the WithKeyedService[T](key, value), but typed
-func WithKeyedValue[T any](key string, value T) Option {
-	return withServiceKey[T](&key, value)
+// Same as the WithKeyedService[T](key, value), but typed.
+// The function values are added as the instances, not as the factories
+func WithKeyedValue[T any](key string, value T, svcOpts ...ServiceOption) Option {
+	return withServiceInstance[T](&key, value, svcOpts)
 }
 
 // factoryOption adds a new service factory to the Container
 type factoryOption struct {
 	factory any
 	key     *string
+	svcOpts []ServiceOption
 }
 
 // apply applies the Option
@@ -151,21 +199,48 @@ func (opt *factoryOption) apply(c *Container) {
 	f := newServiceFactory(opt.factory)
 	id := newServiceIdentifier(f.ReturnType, opt.key)
 	accessor := newServiceAccessor(id, c, f, nil)
+	accessor.applyOptions(opt.svcOpts)
 	c.appendAccessor(id, accessor)
 }
 
 // WithFactory adds a new service factory to the Container
-func WithFactory(factory any) Option {
+func WithFactory(factory any, svcOpts ...ServiceOption) Option {
 	return &factoryOption{
 		factory: factory,
+		svcOpts: svcOpts,
 	}
 }
 
 // WithKeyedFactory adds a new keyed service factory to the Container
-func WithKeyedFactory(key string, factory any) Option {
+func WithKeyedFactory(key string, factory any, svcOpts ...ServiceOption) Option {
 	return &factoryOption{
 		factory: factory,
 		key:     &key,
+		svcOpts: svcOpts,
+	}
+}
+
+// startConcurrencyOption sets the Container start concurrency
+type startConcurrencyOption struct {
+	workers int
+}
+
+// apply applies the Option
+func (opt *startConcurrencyOption) apply(c *Container) {
+	if opt.workers < 1 {
+		log.Panicf("[%d]: start concurrency must be positive\n", opt.workers)
+	}
+
+	c.startConcurrency = opt.workers
+}
+
+// WithStartConcurrency sets the max number of the services
+// created concurrently by the Container.Start method.
+//
+// Defaults to the runtime.GOMAXPROCS value
+func WithStartConcurrency(workers int) Option {
+	return &startConcurrencyOption{
+		workers: workers,
 	}
 }
 
@@ -173,29 +248,79 @@ func WithKeyedFactory(key string, factory any) Option {
 type Container struct {
 	// accessors is a map for service identifiers of service descriptors lists
 	accessors serviceAccessors
+
+	// registrations are all the Container accessors in the registration order
+	registrations []*serviceAccessor
+
+	// startConcurrency is the max number of the services created concurrently on start
+	startConcurrency int
+
+	// fallbacks are the Resolvers resolving the services not added to the Container
+	fallbacks []Resolver
+
+	// deferred are the WhenMissing options applied after all the other options
+	deferred []*whenMissingOption
+
+	// lifecycleMu protects the Container from starting and stopping concurrently
+	lifecycleMu sync.Mutex
+
+	// isStarted is true if the Container was started and has not been stopped yet
+	isStarted bool
+
+	// started are the accessors started by the Container in the start order
+	started []*serviceAccessor
+
+	// createdMu protects the created accessors
+	createdMu sync.Mutex
+
+	// created are the accessors whose instances were created by the Container in the creation order
+	created []*serviceAccessor
+
+	// parent is the Container the scope was created from.
+	// nil for the root Container
+	parent *Container
+
+	// scopedMu protects the scoped accessors
+	scopedMu sync.Mutex
+
+	// scoped are the scope's own accessors of the Scoped services
+	// for the accessors registered in the parent Containers
+	scoped map[*serviceAccessor]*serviceAccessor
 }
 
-// NewContainer creates a new Container
+// NewContainer creates a new Container.
+//
+// Panics with the ValidationError message if any of the services
+// marked with the ValidateOnBuild option is invalid.
+// Same as the NewBuilder(opts...).MustBuild()
 func NewContainer(opts ...Option) *Container {
-	c := &Container{
-		accessors: make(serviceAccessors),
-	}
+	return NewBuilder(opts...).MustBuild()
+}
 
+// applyOptions applies the provided options extended with the default ones to the Container
+func (c *Container) applyOptions(opts []Option) {
 	l := len(opts)
-	extOpts := make([]Option, l, l+1)
+	extOpts := make([]Option, l, l+2)
 	copy(extOpts, opts)
 
 	// Extend options with the default accessors
+	builtin := []ServiceOption{&builtinOption{}}
 	extOpts = append(
 		extOpts,
-		withServiceInstance[ServiceGetter](nil, c),
+		withServiceInstance[ServiceGetter](nil, c, builtin),
+		withServiceInstance[*Container](nil, c, builtin),
 	)
 
 	for _, opt := range extOpts {
 		opt.apply(c)
 	}
 
-	return c
+	// the deferred options may add more deferred options
+	for len(c.deferred) > 0 {
+		opt := c.deferred[0]
+		c.deferred = c.deferred[1:]
+		opt.applyDeferred(c)
+	}
 }
 
 // appendAccessor appends a service accessor to the container to the provided id
@@ -206,19 +331,206 @@ func (c *Container) appendAccessor(id serviceIdentifier, accessor *serviceAccess
 	} else {
 		c.accessors[id] = newServiceAccessorsList(accessor)
 	}
+
+	c.registrations = append(c.registrations, accessor)
+}
+
+// factoryDepAccessors returns the accessors
+// used to resolve the dependencies of the provided factory
+func (c *Container) factoryDepAccessors(factory *serviceFactory) []*serviceAccessor {
+	var deps []*serviceAccessor
+	for _, depType := range factory.DepTypes {
+		if depType == contextType {
+			continue
+		}
+
+		isSlice := depType.Kind() == reflect.Slice
+		if isSlice {
+			depType = depType.Elem()
+		}
+
+		accessors, ok := c.accessors[serviceIdentifier{Type: depType}]
+		if !ok {
+			continue
+		}
+
+		if !isSlice {
+			deps = append(deps, accessors.Last())
+			continue
+		}
+
+		for _, accessor := range accessors.Iter() {
+			deps = append(deps, accessor)
+		}
+	}
+
+	return deps
+}
+
+// Unresolved returns the services added to the Container which were never requested
+// from the Container, its scopes or as the dependencies of the other services
+// in the registration order
+func (c *Container) Unresolved() []string {
+	var unresolved []string
+	for _, accessor := range c.registrations {
+		if !accessor.builtin && !accessor.resolved.Load() {
+			unresolved = append(unresolved, accessor.id.String())
+		}
+	}
+
+	return unresolved
+}
+
+// Start eagerly creates all the Container services added with a factory
+// and then runs the services start hooks.
+//
+// The services are created in the dependency order,
+// independent services are created concurrently limited by the WithStartConcurrency option.
+// The start hooks and the Starter implementations are called sequentially in the dependency order.
+// If any of them fails, the already started services are stopped in the reverse order.
+//
+// Returns the first service creation error, the start error or ErrCircularDependency
+// if the services depend on each other
+func (c *Container) Start(ctx context.Context) error {
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
+	if c.isStarted {
+		return ErrAlreadyStarted
+	}
+
+	g := newDependencyGraph(c, c.singletons())
+	sorted, err := g.sort()
+	if err != nil {
+		return err
+	}
+
+	err = g.walk(ctx, c.startConcurrency, func(ctx context.Context, accessor *serviceAccessor) error {
+		_, err := accessor.Instance(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, accessor := range sorted {
+		if err = accessor.start(ctx); err != nil {
+			err = fmt.Errorf("di: failed to start service %q: %w", accessor.id.Type, err)
+			return errors.Join(err, c.stopStarted(ctx))
+		}
+
+		c.started = append(c.started, accessor)
+	}
+
+	c.isStarted = true
+	return nil
+}
+
+// Stop runs the stop hooks and the Stopper implementations
+// of the started services in the reverse start order.
+//
+// All the services are stopped even if some of them fail, the errors are joined
+func (c *Container) Stop(ctx context.Context) error {
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
+	c.isStarted = false
+	return c.stopStarted(ctx)
+}
+
+// trackCreated remembers the accessor whose instance was created by the factory
+// if the instance implements the io.Closer interface, so it is closed by the Container.Close.
+//
+// The Transient services created by the root Container are never tracked,
+// the root Container lives as long as the application and would track them without bound
+func (c *Container) trackCreated(accessor *serviceAccessor, instance reflect.Value) {
+	if accessor.lifetime == Transient && c.parent == nil {
+		return
+	}
+
+	if !instance.IsValid() {
+		return
+	}
+
+	if _, ok := instance.Interface().(io.Closer); !ok {
+		return
+	}
+
+	c.createdMu.Lock()
+	defer c.createdMu.Unlock()
+
+	c.created = append(c.created, accessor)
+}
+
+// Close disposes the services created by the Container factories
+// which implement the io.Closer interface in the reverse creation order.
+//
+// The services added with an instance are never closed.
+// All the services are closed even if some of them fail, the errors are joined
+func (c *Container) Close() error {
+	c.createdMu.Lock()
+	created := c.created
+	c.created = nil
+	c.createdMu.Unlock()
+
+	return closeCreated(created)
+}
+
+// closeCreated closes the created services implementing the io.Closer interface
+// in the reverse creation order
+func closeCreated(created []*serviceAccessor) error {
+	var errs []error
+	for i := len(created) - 1; i >= 0; i-- {
+		accessor := created[i]
+		instance, _ := accessor.Instance(context.Background())
+
+		closer, ok := instance.Interface().(io.Closer)
+		if !ok {
+			continue
+		}
+
+		if err := closer.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("di: failed to close service %q: %w", accessor.id.Type, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// stopStarted stops the started services in the reverse start order
+func (c *Container) stopStarted(ctx context.Context) error {
+	var errs []error
+	for i := len(c.started) - 1; i >= 0; i-- {
+		accessor := c.started[i]
+		if err := accessor.stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("di: failed to stop service %q: %w", accessor.id.Type, err))
+		}
+	}
+
+	c.started = nil
+	return errors.Join(errs...)
 }
 
-// resolveFactoryDeps returns a slice of service dependency for the provided factory
-func (c *Container) resolveFactoryDeps(factory *serviceFactory) ([]reflect.Value, error) {
+// resolveFactoryDeps returns a slice of service dependency for the provided factory.
+// The context.Context dependency receives the provided resolving context
+func (c *Container) resolveFactoryDeps(ctx context.Context, factory *serviceFactory) ([]reflect.Value, error) {
 	serviceDeps := make([]reflect.Value, factory.DepsCount)
 
-	for i := 0; i < factory.DepsCount; i++ {
-		depType := factory.Type.In(i)
+	for i, depType := range factory.DepTypes {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if depType == contextType {
+			serviceDeps[i] = reflect.ValueOf(&ctx).Elem()
+			continue
+		}
+
 		depID := serviceIdentifier{
 			Type: depType,
 		}
 
-		dep, err := c.getService(depID)
+		dep, err := c.getService(ctx, depID)
 		if err != nil {
 			return nil, &DependencyError{
 				RequestingType: factory.ReturnType,
@@ -234,29 +546,33 @@ func (c *Container) resolveFactoryDeps(factory *serviceFactory) ([]reflect.Value
 }
 
 // getService gets a service instance for the provided service identifier
-func (c *Container) getService(id serviceIdentifier) (reflect.Value, error) {
+func (c *Container) getService(ctx context.Context, id serviceIdentifier) (reflect.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return reflect.Zero(id.Type), err
+	}
+
 	isSlice := id.Type.Kind() == reflect.Slice
 	if isSlice {
 		id.Type = id.Type.Elem()
 	}
 
-	accessors, ok := c.accessors[id]
+	accessors, ok := c.lookupAccessors(id)
 	if !ok {
 		if isSlice {
-			return reflect.Zero(reflect.SliceOf(id.Type)), ErrServiceNotFound
+			id.Type = reflect.SliceOf(id.Type)
 		}
-		return reflect.Zero(id.Type), ErrServiceNotFound
+		return c.resolveFallback(ctx, id)
 	}
 
 	if !isSlice {
 		accessor := accessors.Last()
-		return accessor.Instance()
+		return c.instance(ctx, accessor)
 	}
 
 	slTyp := reflect.SliceOf(id.Type)
 	res := reflect.MakeSlice(slTyp, accessors.Len(), accessors.Len())
 	for i, accessor := range accessors.Iter() {
-		instance, err := accessor.Instance()
+		instance, err := c.instance(ctx, accessor)
 		if err != nil {
 			return reflect.Zero(slTyp), err
 		}
@@ -267,19 +583,63 @@ func (c *Container) getService(id serviceIdentifier) (reflect.Value, error) {
 	return res, nil
 }
 
+// cachedInstance returns the already created Singleton service instance
+// or the service instance added to the Container for the provided service identifier.
+//
+// The fast path of the service resolution never locking, creating the instance or allocating
+func (c *Container) cachedInstance(id serviceIdentifier) (any, bool) {
+	if id.Type.Kind() == reflect.Slice {
+		return nil, false
+	}
+
+	accessors, ok := c.lookupAccessors(id)
+	if !ok {
+		return nil, false
+	}
+
+	accessor := accessors.Last()
+	if accessor.factory != nil && accessor.lifetime != Singleton {
+		return nil, false
+	}
+
+	instance, ok := accessor.cached()
+	if ok {
+		accessor.markResolved()
+	}
+
+	return instance, ok
+}
+
 // getServiceKey returns an asserted service instance
 // for the provided type and key
 // from the provided ServiceGetter
-func getServiceKey[T any](sg ServiceGetter, key *string) (T, error) {
+func getServiceKey[T any](ctx context.Context, sg ServiceGetter, key *string) (T, error) {
 	id := newServiceIdentifier(reflect.TypeFor[T](), key)
-	service, err := sg.getService(id)
-	return service.Interface().(T), err
+	if c, ok := sg.(*Container); ok {
+		if instance, ok := c.cachedInstance(id); ok {
+			res, _ := instance.(T)
+			return res, nil
+		}
+	}
+
+	service, err := sg.getService(ctx, id)
+	res, _ := service.Interface().(T)
+	return res, err
 }
 
 // GetService returns the asserted service instance for the provided type
 // from the provided ServiceGetter
 func GetService[T any](sg ServiceGetter) (T, error) {
-	return getServiceKey[T](sg, nil)
+	return getServiceKey[T](context.Background(), sg, nil)
+}
+
+// GetServiceCtx returns the asserted service instance for the provided type
+// from the provided ServiceGetter.
+//
+// The factories declaring a context.Context parameter receive the provided context,
+// the resolution stops with the context error as soon as the context is done
+func GetServiceCtx[T any](ctx context.Context, sg ServiceGetter) (T, error) {
+	return getServiceKey[T](ctx, sg, nil)
 }
 
 // MustGetService returns the asserted service instance for the provided type
@@ -299,10 +659,27 @@ func MustGetService[T any](sg ServiceGetter) T {
 	return service
 }
 
+// GetServiceValue returns the service instance for the provided type
+// from the provided ServiceGetter resolving it the same way as the factory dependencies,
+// so the context.Context type is resolved to the provided context
+func GetServiceValue(ctx context.Context, sg ServiceGetter, typ reflect.Type) (reflect.Value, error) {
+	if typ == contextType {
+		return reflect.ValueOf(&ctx).Elem(), nil
+	}
+
+	return sg.getService(ctx, newServiceIdentifier(typ, nil))
+}
+
 // GetKeyedService returns the asserted service instance for the provided type and key
 // from the provided ServiceGetter
 func GetKeyedService[T any](sg ServiceGetter, key string) (T, error) {
-	return getServiceKey[T](sg, &key)
+	return getServiceKey[T](context.Background(), sg, &key)
+}
+
+// GetKeyedServiceCtx returns the asserted service instance for the provided type and key
+// from the provided ServiceGetter resolving it with the provided context
+func GetKeyedServiceCtx[T any](ctx context.Context, sg ServiceGetter, key string) (T, error) {
+	return getServiceKey[T](ctx, sg, &key)
 }
 
 // MustGetKeyedService returns the asserted service instance for the provided type and key
@@ -5,11 +5,15 @@
 package di
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // WithServiceSuite is the suite for testing the WithService function
@@ -159,6 +163,42 @@ func TestWithValue(t *testing.T) {
 	assert.NoError(t, lastAccessor.err)
 }
 
+// TestWithValueFunc tests that the WithValue function adds the function value as the instance
+func TestWithValueFunc(t *testing.T) {
+	// Arrange
+	fn := func() string { return "test" }
+
+	c := NewContainer(WithValue(fn))
+
+	// Act
+	res, err := GetService[func() string](c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "test", res())
+}
+
+// TestWithOverride tests that the WithOverride function replaces the services added before
+func TestWithOverride(t *testing.T) {
+	// Arrange
+	c := NewContainer(
+		WithValue("first"),
+		WithKeyedValue("key", "keyed"),
+		WithFactory(func() string { return "second" }),
+		WithOverride("override"),
+		WithValue("after"),
+	)
+
+	// Act
+	res, err := GetService[[]string](c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"override", "after"}, res)
+	assert.Equal(t, "keyed", MustGetKeyedService[string](c, "key"))
+	assert.Empty(t, c.Unresolved())
+}
+
 // TestWithKeyedValue tests the WithKeyedValue function
 func TestWithKeyedValue(t *testing.T) {
 	// Arrange
@@ -324,11 +364,238 @@ func (suite *GetServiceSuite) TestInstance() {
 	suite.NoError(err)
 }
 
+// TestFactoryErrorRetry tests the factory service error is not cached
+// with the retrying failure policy
+func (suite *GetServiceSuite) TestFactoryErrorRetry() {
+	// Arrange
+	inst := "test"
+	failed := false
+	f := func() (string, error) {
+		if !failed {
+			failed = true
+			return "", errors.ErrUnsupported
+		}
+
+		return inst, nil
+	}
+	c := NewContainer(WithFactory(f, WithFailurePolicy(RetryOnResolve())))
+
+	// Act
+	_, err1 := GetService[string](c)
+	res, err2 := GetService[string](c)
+
+	// Assert
+	suite.Error(err1)
+	suite.NoError(err2)
+	suite.Equal(inst, res)
+}
+
+// TestInterfaceNotFound tests that the missing interface service returns the error
+func (suite *GetServiceSuite) TestInterfaceNotFound() {
+	// Arrange
+	c := NewContainer()
+
+	// Act
+	res, err := GetService[fmt.Stringer](c)
+
+	// Assert
+	suite.ErrorIs(err, ErrServiceNotFound)
+	suite.Nil(res)
+}
+
 // TestGetService tests the GetService function
 func TestGetService(t *testing.T) {
 	suite.Run(t, new(GetServiceSuite))
 }
 
+// GetServiceCtxSuite is the suite for testing the GetServiceCtx function
+type GetServiceCtxSuite struct {
+	suite.Suite
+}
+
+// ctxKey is the context key type used in the tests
+type ctxKey struct{}
+
+// TestContextDependency tests the factory receives the resolving context
+func (suite *GetServiceCtxSuite) TestContextDependency() {
+	// Arrange
+	ctx := context.WithValue(context.Background(), ctxKey{}, "test")
+	f := func(ctx context.Context) string {
+		return ctx.Value(ctxKey{}).(string)
+	}
+	c := NewContainer(WithFactory(f))
+
+	// Act
+	res, err := GetServiceCtx[string](ctx, c)
+
+	// Assert
+	suite.Equal("test", res)
+	suite.NoError(err)
+}
+
+// TestCancelled tests the resolution stops for the cancelled context
+// and the error is not cached
+func (suite *GetServiceCtxSuite) TestCancelled() {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	timesCalled := 0
+	f := func(n int) string {
+		timesCalled++
+		return "test"
+	}
+	c := NewContainer(WithValue(1), WithFactory(f))
+
+	// Act
+	_, err1 := GetServiceCtx[string](ctx, c)
+	res, err2 := GetService[string](c)
+
+	// Assert
+	suite.ErrorIs(err1, context.Canceled)
+	suite.NoError(err2)
+	suite.Equal("test", res)
+	suite.Equal(1, timesCalled)
+}
+
+// TestDeadlineExceeded tests the retrying resolution stops on the context deadline
+func (suite *GetServiceCtxSuite) TestDeadlineExceeded() {
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	f := func() (string, error) {
+		return "", errors.ErrUnsupported
+	}
+	c := NewContainer(WithFactory(f, WithFailurePolicy(RetryWithBackoff(10, time.Hour))))
+
+	// Act
+	_, err := GetServiceCtx[string](ctx, c)
+
+	// Assert
+	suite.ErrorIs(err, context.DeadlineExceeded)
+}
+
+// TestGetServiceCtx tests the GetServiceCtx function
+func TestGetServiceCtx(t *testing.T) {
+	suite.Run(t, new(GetServiceCtxSuite))
+}
+
+// StartSuite is the suite for testing the Container.Start method
+type StartSuite struct {
+	suite.Suite
+}
+
+// TestCreatesServices tests all the factory services are created
+func (suite *StartSuite) TestCreatesServices() {
+	// Arrange
+	timesCalled := 0
+	c := NewContainer(
+		WithValue(1),
+		WithFactory(func(n int) string {
+			timesCalled++
+			return "test"
+		}),
+	)
+
+	// Act
+	err := c.Start(context.Background())
+	res, getErr := GetService[string](c)
+
+	// Assert
+	suite.NoError(err)
+	suite.NoError(getErr)
+	suite.Equal("test", res)
+	suite.Equal(1, timesCalled)
+}
+
+// TestConcurrent tests the independent services are created concurrently
+func (suite *StartSuite) TestConcurrent() {
+	// Arrange
+	const services = 3
+	var running, maxRunning atomic.Int32
+	all := make(chan struct{})
+
+	// create blocks until all the services are being created or the timeout elapses
+	create := func() {
+		cur := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			prev := maxRunning.Load()
+			if cur <= prev || maxRunning.CompareAndSwap(prev, cur) {
+				break
+			}
+		}
+
+		if cur == services {
+			close(all)
+		}
+
+		select {
+		case <-all:
+		case <-time.After(time.Second):
+		}
+	}
+
+	c := NewContainer(
+		WithStartConcurrency(services),
+		WithFactory(func() *graphA {
+			create()
+			return &graphA{}
+		}),
+		WithFactory(func() *graphB {
+			create()
+			return &graphB{}
+		}),
+		WithFactory(func() *graphC {
+			create()
+			return &graphC{}
+		}),
+	)
+
+	// Act
+	err := c.Start(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(int32(services), maxRunning.Load())
+}
+
+// TestError tests the factory error is returned
+func (suite *StartSuite) TestError() {
+	// Arrange
+	c := NewContainer(WithFactory(func() (string, error) {
+		return "", errors.ErrUnsupported
+	}))
+
+	// Act
+	err := c.Start(context.Background())
+
+	// Assert
+	suite.ErrorIs(err, errors.ErrUnsupported)
+}
+
+// TestCircularDependency tests the circular dependency is reported
+func (suite *StartSuite) TestCircularDependency() {
+	// Arrange
+	c := NewContainer(
+		WithFactory(func(*graphB) *graphA { return &graphA{} }),
+		WithFactory(func(*graphA) *graphB { return &graphB{} }),
+	)
+
+	// Act
+	err := c.Start(context.Background())
+
+	// Assert
+	suite.ErrorIs(err, ErrCircularDependency)
+}
+
+// TestContainer_Start tests the Container.Start method
+func TestContainer_Start(t *testing.T) {
+	suite.Run(t, new(StartSuite))
+}
+
 // GetKeyedServiceSuite is the suite for testing the GetKeyedService function
 type GetKeyedServiceSuite struct {
 	suite.Suite
@@ -501,3 +768,66 @@ func (suite *MustGetKeyedServiceSuite) TestInstance() {
 func TestMustGetKeyedService(t *testing.T) {
 	suite.Run(t, new(MustGetKeyedServiceSuite))
 }
+
+// GetServiceValueSuite is the suite for testing the GetServiceValue function
+type GetServiceValueSuite struct {
+	suite.Suite
+}
+
+// TestService tests the service is resolved for the provided type
+func (suite *GetServiceValueSuite) TestService() {
+	// Arrange
+	c := NewContainer(WithValue("test"))
+
+	// Act
+	res, err := GetServiceValue(context.Background(), c, reflect.TypeFor[string]())
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal("test", res.Interface())
+}
+
+// TestContext tests the context type is resolved to the provided context
+func (suite *GetServiceValueSuite) TestContext() {
+	// Arrange
+	ctx := context.WithValue(context.Background(), ctxKey{}, "test")
+
+	// Act
+	res, err := GetServiceValue(ctx, NewContainer(), reflect.TypeFor[context.Context]())
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(ctx, res.Interface())
+}
+
+// TestGetServiceValue tests the GetServiceValue function
+func TestGetServiceValue(t *testing.T) {
+	suite.Run(t, new(GetServiceValueSuite))
+}
+
+// TestUnresolved tests that the Unresolved method returns the services never requested
+func TestUnresolved(t *testing.T) {
+	// Arrange
+	c := NewContainer(
+		WithValue("value"),
+		WithValue(1),
+		WithFactory(func(i int) *int { return &i }),
+		WithFactory(func() float64 { return 1.5 }, WithLifetime(Scoped)),
+		WithKeyedValue("key", true),
+	)
+
+	// Act
+	unresolved := c.Unresolved()
+
+	// Assert
+	assert.Equal(t, []string{"string", "int", "*int", "float64", "bool:key"}, unresolved)
+
+	MustGetService[*int](c)
+	MustGetService[*int](c)
+
+	scope := c.NewScope()
+	defer scope.Close()
+	MustGetService[float64](scope)
+
+	assert.Equal(t, []string{"string", "bool:key"}, c.Unresolved())
+}
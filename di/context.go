@@ -0,0 +1,58 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrNoServiceGetter is the error returned when the context carries no ServiceGetter
+	ErrNoServiceGetter = errors.New("di: no service getter attached to the context, use the di.ContextWith function")
+)
+
+// serviceGetterKey is the context key of the ServiceGetter
+type serviceGetterKey struct{}
+
+// ContextWith returns a copy of the context carrying the provided ServiceGetter,
+// either the root Container or any of its scopes
+func ContextWith(ctx context.Context, sg ServiceGetter) context.Context {
+	return context.WithValue(ctx, serviceGetterKey{}, sg)
+}
+
+// FromContext returns the ServiceGetter attached to the context with the ContextWith function
+func FromContext(ctx context.Context) (ServiceGetter, bool) {
+	sg, ok := ctx.Value(serviceGetterKey{}).(ServiceGetter)
+	return sg, ok
+}
+
+// GetServiceFromContext returns the asserted service instance for the provided type
+// from the ServiceGetter attached to the context, resolving it with the context.
+//
+// Returns ErrNoServiceGetter if the context carries no ServiceGetter
+func GetServiceFromContext[T any](ctx context.Context) (T, error) {
+	sg, ok := FromContext(ctx)
+	if !ok {
+		var zero T
+		return zero, ErrNoServiceGetter
+	}
+
+	return GetServiceCtx[T](ctx, sg)
+}
+
+// GetKeyedServiceFromContext returns the asserted service instance for the provided type and key
+// from the ServiceGetter attached to the context, resolving it with the context.
+//
+// Returns ErrNoServiceGetter if the context carries no ServiceGetter
+func GetKeyedServiceFromContext[T any](ctx context.Context, key string) (T, error) {
+	sg, ok := FromContext(ctx)
+	if !ok {
+		var zero T
+		return zero, ErrNoServiceGetter
+	}
+
+	return GetKeyedServiceCtx[T](ctx, sg, key)
+}
@@ -0,0 +1,74 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+// ContextSuite is the suite for testing the ServiceGetter context functions
+type ContextSuite struct {
+	suite.Suite
+}
+
+// TestRoot tests the services are resolved from the root Container attached to the context
+func (suite *ContextSuite) TestRoot() {
+	// Arrange
+	c := NewContainer(WithValue("test"), WithKeyedValue("key", 1))
+	ctx := ContextWith(context.Background(), c)
+
+	// Act
+	sg, ok := FromContext(ctx)
+	res, err := GetServiceFromContext[string](ctx)
+	keyed, keyedErr := GetKeyedServiceFromContext[int](ctx, "key")
+
+	// Assert
+	suite.True(ok)
+	suite.Same(c, sg)
+	suite.NoError(err)
+	suite.Equal("test", res)
+	suite.NoError(keyedErr)
+	suite.Equal(1, keyed)
+}
+
+// TestScope tests the Scoped services are resolved from the scope attached to the context
+func (suite *ContextSuite) TestScope() {
+	// Arrange
+	c := NewContainer(WithFactory(func() *scopedService {
+		return &scopedService{}
+	}, WithLifetime(Scoped)))
+	scope := c.NewScope()
+	ctx := ContextWith(context.Background(), scope)
+
+	// Act
+	res1, err1 := GetServiceFromContext[*scopedService](ctx)
+	res2, err2 := GetService[*scopedService](scope)
+
+	// Assert
+	suite.NoError(err1)
+	suite.NoError(err2)
+	suite.Same(res1, res2)
+}
+
+// TestNoServiceGetter tests the error is returned for the context without the ServiceGetter
+func (suite *ContextSuite) TestNoServiceGetter() {
+	// Act
+	sg, ok := FromContext(context.Background())
+	_, err := GetServiceFromContext[string](context.Background())
+	_, keyedErr := GetKeyedServiceFromContext[string](context.Background(), "key")
+
+	// Assert
+	suite.Nil(sg)
+	suite.False(ok)
+	suite.ErrorIs(err, ErrNoServiceGetter)
+	suite.ErrorIs(keyedErr, ErrNoServiceGetter)
+}
+
+// TestContext tests the ServiceGetter context functions
+func TestContext(t *testing.T) {
+	suite.Run(t, new(ContextSuite))
+}
@@ -0,0 +1,193 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrCircularDependency is the error returned when the services depend on each other
+	ErrCircularDependency = errors.New("di: circular dependency detected")
+)
+
+// dependencyGraph is a DAG of the Container services
+type dependencyGraph struct {
+	// nodes are the graph accessors in the registration order
+	nodes []*serviceAccessor
+
+	// deps are the accessors every node depends on
+	deps map[*serviceAccessor][]*serviceAccessor
+
+	// dependents are the accessors depending on every node
+	dependents map[*serviceAccessor][]*serviceAccessor
+}
+
+// newDependencyGraph creates a new dependencyGraph
+// for the provided accessors computed from their factory parameter types
+func newDependencyGraph(c *Container, accessors []*serviceAccessor) *dependencyGraph {
+	g := &dependencyGraph{
+		nodes:      accessors,
+		deps:       make(map[*serviceAccessor][]*serviceAccessor),
+		dependents: make(map[*serviceAccessor][]*serviceAccessor),
+	}
+
+	isNode := make(map[*serviceAccessor]bool, len(accessors))
+	for _, accessor := range accessors {
+		isNode[accessor] = true
+	}
+
+	for _, node := range g.nodes {
+		if node.factory == nil {
+			continue
+		}
+
+		for _, dep := range nodeDeps(c, node, isNode) {
+			g.deps[node] = append(g.deps[node], dep)
+			g.dependents[dep] = append(g.dependents[dep], node)
+		}
+	}
+
+	return g
+}
+
+// nodeDeps returns the graph nodes the accessor depends on.
+//
+// The dependencies which are not the graph nodes, e.g. the Transient services,
+// are followed through to the graph nodes they depend on
+func nodeDeps(c *Container, accessor *serviceAccessor, isNode map[*serviceAccessor]bool) []*serviceAccessor {
+	var deps []*serviceAccessor
+	visited := make(map[*serviceAccessor]bool)
+	pending := c.factoryDepAccessors(accessor.factory)
+	for len(pending) > 0 {
+		dep := pending[0]
+		pending = pending[1:]
+
+		if visited[dep] {
+			continue
+		}
+		visited[dep] = true
+
+		if isNode[dep] {
+			deps = append(deps, dep)
+		} else if dep.factory != nil {
+			pending = append(pending, c.factoryDepAccessors(dep.factory)...)
+		}
+	}
+
+	return deps
+}
+
+// sort returns the graph nodes in the dependency order.
+//
+// Returns ErrCircularDependency if the graph contains a cycle
+func (g *dependencyGraph) sort() ([]*serviceAccessor, error) {
+	pending := make(map[*serviceAccessor]int, len(g.nodes))
+	sorted := make([]*serviceAccessor, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		pending[node] = len(g.deps[node])
+		if pending[node] == 0 {
+			sorted = append(sorted, node)
+		}
+	}
+
+	for i := 0; i < len(sorted); i++ {
+		for _, dependent := range g.dependents[sorted[i]] {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				sorted = append(sorted, dependent)
+			}
+		}
+	}
+
+	if len(sorted) == len(g.nodes) {
+		return sorted, nil
+	}
+
+	cycle := make([]reflect.Type, 0, len(g.nodes)-len(sorted))
+	for _, node := range g.nodes {
+		if pending[node] > 0 {
+			cycle = append(cycle, node.id.Type)
+		}
+	}
+
+	return nil, fmt.Errorf("%w between %v", ErrCircularDependency, cycle)
+}
+
+// walkResult is the result of the single node visit
+type walkResult struct {
+	node *serviceAccessor
+	err  error
+}
+
+// walk calls the visit function for every graph node after all its dependencies were visited.
+// Independent nodes are visited concurrently by up to the workers goroutines.
+//
+// Stops on the first error, cancelling the context passed to the running visits
+func (g *dependencyGraph) walk(
+	ctx context.Context,
+	workers int,
+	visit func(context.Context, *serviceAccessor) error,
+) error {
+	if _, err := g.sort(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, max(workers, 1))
+	results := make(chan walkResult, len(g.nodes))
+	running := 0
+
+	schedule := func(node *serviceAccessor) {
+		running++
+		go func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results <- walkResult{
+				node: node,
+				err:  visit(ctx, node),
+			}
+		}()
+	}
+
+	pending := make(map[*serviceAccessor]int, len(g.nodes))
+	for _, node := range g.nodes {
+		pending[node] = len(g.deps[node])
+		if pending[node] == 0 {
+			schedule(node)
+		}
+	}
+
+	var firstErr error
+	for running > 0 {
+		res := <-results
+		running--
+
+		if firstErr != nil {
+			continue
+		}
+
+		if res.err != nil {
+			firstErr = res.err
+			cancel()
+			continue
+		}
+
+		for _, dependent := range g.dependents[res.node] {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				schedule(dependent)
+			}
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,175 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type (
+	// graphA is the service without dependencies used in the tests
+	graphA struct{}
+
+	// graphB is the service depending on graphA used in the tests
+	graphB struct{}
+
+	// graphC is the service depending on graphA and graphB used in the tests
+	graphC struct{}
+)
+
+// userRegistrations returns the Container registrations
+// without the default ServiceGetter and *Container ones
+func userRegistrations(c *Container) []*serviceAccessor {
+	var res []*serviceAccessor
+	for _, accessor := range c.registrations {
+		switch accessor.id.Type {
+		case reflect.TypeFor[ServiceGetter](), reflect.TypeFor[*Container]():
+		default:
+			res = append(res, accessor)
+		}
+	}
+
+	return res
+}
+
+// DependencyGraphSuite is the suite for testing the dependencyGraph
+type DependencyGraphSuite struct {
+	suite.Suite
+}
+
+// TestSort tests the nodes are sorted in the dependency order
+func (suite *DependencyGraphSuite) TestSort() {
+	// Arrange
+	c := NewContainer(
+		WithFactory(func(*graphA, *graphB) *graphC { return &graphC{} }),
+		WithFactory(func(*graphA) *graphB { return &graphB{} }),
+		WithFactory(func() *graphA { return &graphA{} }),
+	)
+	g := newDependencyGraph(c, userRegistrations(c))
+
+	// Act
+	sorted, err := g.sort()
+
+	// Assert
+	suite.NoError(err)
+	if suite.Len(sorted, 3) {
+		suite.Equal(c.registrations[2], sorted[0])
+		suite.Equal(c.registrations[1], sorted[1])
+		suite.Equal(c.registrations[0], sorted[2])
+	}
+}
+
+// TestSortCycle tests the circular dependency is detected
+func (suite *DependencyGraphSuite) TestSortCycle() {
+	// Arrange
+	c := NewContainer(
+		WithFactory(func(*graphB) *graphA { return &graphA{} }),
+		WithFactory(func(*graphA) *graphB { return &graphB{} }),
+		WithFactory(func(*graphA) *graphC { return &graphC{} }),
+	)
+	g := newDependencyGraph(c, userRegistrations(c))
+
+	// Act
+	sorted, err := g.sort()
+
+	// Assert
+	suite.Nil(sorted)
+	suite.ErrorIs(err, ErrCircularDependency)
+}
+
+// TestWalkOrder tests every node is visited after its dependencies
+func (suite *DependencyGraphSuite) TestWalkOrder() {
+	// Arrange
+	c := NewContainer(
+		WithFactory(func(*graphA, *graphB) *graphC { return &graphC{} }),
+		WithFactory(func(*graphA) *graphB { return &graphB{} }),
+		WithFactory(func() *graphA { return &graphA{} }),
+	)
+	g := newDependencyGraph(c, userRegistrations(c))
+
+	var mu sync.Mutex
+	visited := make([]*serviceAccessor, 0, 3)
+
+	// Act
+	err := g.walk(context.Background(), 3, func(_ context.Context, accessor *serviceAccessor) error {
+		mu.Lock()
+		defer mu.Unlock()
+		visited = append(visited, accessor)
+		return nil
+	})
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal([]*serviceAccessor{c.registrations[2], c.registrations[1], c.registrations[0]}, visited)
+}
+
+// TestWalkConcurrency tests the independent nodes are visited concurrently
+// up to the workers limit
+func (suite *DependencyGraphSuite) TestWalkConcurrency() {
+	// Arrange
+	c := NewContainer(
+		WithFactory(func() *graphA { return &graphA{} }),
+		WithFactory(func() *graphB { return &graphB{} }),
+		WithFactory(func() *graphC { return &graphC{} }),
+		WithFactory(func() string { return "" }),
+	)
+	g := newDependencyGraph(c, userRegistrations(c))
+
+	var running, maxRunning atomic.Int32
+
+	// Act
+	err := g.walk(context.Background(), 2, func(context.Context, *serviceAccessor) error {
+		cur := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			prev := maxRunning.Load()
+			if cur <= prev || maxRunning.CompareAndSwap(prev, cur) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(int32(2), maxRunning.Load())
+}
+
+// TestWalkError tests the dependents are not visited after the error
+func (suite *DependencyGraphSuite) TestWalkError() {
+	// Arrange
+	c := NewContainer(
+		WithFactory(func() *graphA { return &graphA{} }),
+		WithFactory(func(*graphA) *graphB { return &graphB{} }),
+	)
+	g := newDependencyGraph(c, userRegistrations(c))
+
+	visits := 0
+
+	// Act
+	err := g.walk(context.Background(), 1, func(context.Context, *serviceAccessor) error {
+		visits++
+		return errors.ErrUnsupported
+	})
+
+	// Assert
+	suite.ErrorIs(err, errors.ErrUnsupported)
+	suite.Equal(1, visits)
+}
+
+// TestDependencyGraph tests the dependencyGraph
+func TestDependencyGraph(t *testing.T) {
+	suite.Run(t, new(DependencyGraphSuite))
+}
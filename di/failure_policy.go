@@ -0,0 +1,58 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import "time"
+
+// FailurePolicy defines how a service accessor handles the service factory errors.
+//
+// The zero value caches the first error, so the service stays failed
+// for the whole container lifetime
+type FailurePolicy struct {
+	// retry is true if a failed instance creation
+	// must be retried on the next resolve instead of being cached
+	retry bool
+
+	// maxAttempts is the max number of the factory calls per resolve.
+	// The factory is called once if maxAttempts is less than 2
+	maxAttempts int
+
+	// backoff is the delay before the second attempt,
+	// doubled after every next failed attempt
+	backoff time.Duration
+}
+
+// CacheFailure returns the FailurePolicy caching the factory error forever.
+//
+// This is the default policy
+func CacheFailure() FailurePolicy {
+	return FailurePolicy{}
+}
+
+// RetryOnResolve returns the FailurePolicy which does not cache the factory error,
+// so the factory is called again on the next resolve
+func RetryOnResolve() FailurePolicy {
+	return FailurePolicy{
+		retry: true,
+	}
+}
+
+// RetryWithBackoff returns the FailurePolicy which calls the factory
+// up to maxAttempts times per resolve, waiting for the backoff before the second attempt
+// and doubling it after every next failed attempt.
+//
+// The error is not cached, so the factory is called again on the next resolve
+func RetryWithBackoff(maxAttempts int, backoff time.Duration) FailurePolicy {
+	return FailurePolicy{
+		retry:       true,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// attempts returns the max number of the factory calls per resolve
+func (policy FailurePolicy) attempts() int {
+	return max(policy.maxAttempts, 1)
+}
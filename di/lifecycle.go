@@ -0,0 +1,120 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrAlreadyStarted is the error returned when the Container is started twice
+	ErrAlreadyStarted = errors.New("di: container is already started")
+)
+
+// Starter is an interface for the services started by the Container.Start method.
+//
+// Detected only for the services created by the Container factories
+type Starter interface {
+	// Start starts the service
+	Start(ctx context.Context) error
+}
+
+// Stopper is an interface for the services stopped by the Container.Stop method.
+//
+// Detected only for the services created by the Container factories
+type Stopper interface {
+	// Stop stops the service
+	Stop(ctx context.Context) error
+}
+
+// lifecycleHook is a service start or stop hook
+type lifecycleHook func(ctx context.Context, instance reflect.Value) error
+
+// newLifecycleHook creates a new lifecycleHook calling the provided typed hook
+func newLifecycleHook[T any](hook func(context.Context, T) error) lifecycleHook {
+	return func(ctx context.Context, instance reflect.Value) error {
+		service, ok := instance.Interface().(T)
+		if !ok {
+			return fmt.Errorf("di: service of type %q can not be passed to the %q hook",
+				instance.Type(), reflect.TypeFor[T]())
+		}
+
+		return hook(ctx, service)
+	}
+}
+
+// lifecycleHookOption adds the start or stop hook to the service
+type lifecycleHookOption struct {
+	hook    lifecycleHook
+	isStart bool
+}
+
+// applyService applies the ServiceOption
+func (opt *lifecycleHookOption) applyService(accessor *serviceAccessor) {
+	if opt.isStart {
+		accessor.onStart = append(accessor.onStart, opt.hook)
+	} else {
+		accessor.onStop = append(accessor.onStop, opt.hook)
+	}
+}
+
+// OnStart adds the hook called with the service instance by the Container.Start method
+func OnStart[T any](hook func(ctx context.Context, service T) error) ServiceOption {
+	return &lifecycleHookOption{
+		hook:    newLifecycleHook(hook),
+		isStart: true,
+	}
+}
+
+// OnStop adds the hook called with the service instance by the Container.Stop method
+func OnStop[T any](hook func(ctx context.Context, service T) error) ServiceOption {
+	return &lifecycleHookOption{
+		hook: newLifecycleHook(hook),
+	}
+}
+
+// start calls the Starter implementation and the start hooks of the accessor's service
+func (accessor *serviceAccessor) start(ctx context.Context) error {
+	instance, err := accessor.Instance(ctx)
+	if err != nil {
+		return err
+	}
+
+	if starter, ok := instance.Interface().(Starter); ok && accessor.factory != nil {
+		if err = starter.Start(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, hook := range accessor.onStart {
+		if err = hook(ctx, instance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stop calls the stop hooks and the Stopper implementation of the accessor's service
+func (accessor *serviceAccessor) stop(ctx context.Context) error {
+	instance, err := accessor.Instance(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, hook := range accessor.onStop {
+		errs = append(errs, hook(ctx, instance))
+	}
+
+	if stopper, ok := instance.Interface().(Stopper); ok && accessor.factory != nil {
+		errs = append(errs, stopper.Stop(ctx))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,229 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+// lifecycleLog is the log of the lifecycle events used in the tests
+type lifecycleLog struct {
+	events []string
+}
+
+// lifecycleService is the Starter and Stopper implementation used in the tests
+type lifecycleService struct {
+	name     string
+	log      *lifecycleLog
+	startErr error
+}
+
+// Start implements the Starter interface
+func (s *lifecycleService) Start(context.Context) error {
+	s.log.events = append(s.log.events, "start "+s.name)
+	return s.startErr
+}
+
+// Stop implements the Stopper interface
+func (s *lifecycleService) Stop(context.Context) error {
+	s.log.events = append(s.log.events, "stop "+s.name)
+	return nil
+}
+
+type (
+	// lifecycleA is the lifecycle service without dependencies used in the tests
+	lifecycleA struct{ *lifecycleService }
+
+	// lifecycleB is the lifecycle service depending on lifecycleA used in the tests
+	lifecycleB struct{ *lifecycleService }
+
+	// lifecycleLink is the Transient service depending on lifecycleA used in the tests
+	lifecycleLink struct{ a lifecycleA }
+)
+
+// closerService is the io.Closer implementation used in the tests
+type closerService struct {
+	name string
+	log  *lifecycleLog
+}
+
+// Close implements the io.Closer interface
+func (s *closerService) Close() error {
+	s.log.events = append(s.log.events, "close "+s.name)
+	return nil
+}
+
+type (
+	// closerA is the closer service without dependencies used in the tests
+	closerA struct{ *closerService }
+
+	// closerB is the closer service depending on closerA used in the tests
+	closerB struct{ *closerService }
+)
+
+// LifecycleSuite is the suite for testing the Container lifecycle
+type LifecycleSuite struct {
+	suite.Suite
+}
+
+// newContainer creates a new Container with the lifecycleB registered before its lifecycleA dependency
+func (suite *LifecycleSuite) newContainer(log *lifecycleLog, startErrB error) *Container {
+	return NewContainer(
+		WithFactory(func(lifecycleA) lifecycleB {
+			return lifecycleB{&lifecycleService{name: "b", log: log, startErr: startErrB}}
+		}),
+		WithFactory(func() lifecycleA {
+			return lifecycleA{&lifecycleService{name: "a", log: log}}
+		}),
+	)
+}
+
+// TestOrder tests the services are started in the dependency order
+// and stopped in the reverse order
+func (suite *LifecycleSuite) TestOrder() {
+	// Arrange
+	log := &lifecycleLog{}
+	c := suite.newContainer(log, nil)
+
+	// Act
+	startErr := c.Start(context.Background())
+	stopErr := c.Stop(context.Background())
+
+	// Assert
+	suite.NoError(startErr)
+	suite.NoError(stopErr)
+	suite.Equal([]string{"start a", "start b", "stop b", "stop a"}, log.events)
+}
+
+// TestTransientDependency tests the services are started after the services
+// they depend on through the Transient services
+func (suite *LifecycleSuite) TestTransientDependency() {
+	// Arrange
+	log := &lifecycleLog{}
+	c := NewContainer(
+		WithStartConcurrency(1),
+		WithFactory(func(lifecycleLink) lifecycleB {
+			return lifecycleB{&lifecycleService{name: "b", log: log}}
+		}),
+		WithFactory(func(a lifecycleA) lifecycleLink {
+			return lifecycleLink{a: a}
+		}, WithLifetime(Transient)),
+		WithFactory(func() lifecycleA {
+			return lifecycleA{&lifecycleService{name: "a", log: log}}
+		}),
+	)
+
+	// Act
+	err := c.Start(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal([]string{"start a", "start b"}, log.events)
+}
+
+// TestRollback tests the started services are stopped when the next one fails to start
+func (suite *LifecycleSuite) TestRollback() {
+	// Arrange
+	log := &lifecycleLog{}
+	c := suite.newContainer(log, errors.ErrUnsupported)
+
+	// Act
+	err := c.Start(context.Background())
+
+	// Assert
+	suite.ErrorIs(err, errors.ErrUnsupported)
+	suite.Equal([]string{"start a", "start b", "stop a"}, log.events)
+}
+
+// TestHooks tests the OnStart and OnStop hooks are called with the service instance
+func (suite *LifecycleSuite) TestHooks() {
+	// Arrange
+	var started, stopped string
+	c := NewContainer(WithValue(
+		"test",
+		OnStart(func(_ context.Context, s string) error {
+			started = s
+			return nil
+		}),
+		OnStop(func(_ context.Context, s string) error {
+			stopped = s
+			return nil
+		}),
+	))
+
+	// Act
+	startErr := c.Start(context.Background())
+	stopErr := c.Stop(context.Background())
+
+	// Assert
+	suite.NoError(startErr)
+	suite.NoError(stopErr)
+	suite.Equal("test", started)
+	suite.Equal("test", stopped)
+}
+
+// TestInstanceNotDetected tests the Starter implementation is not called
+// for the services added with an instance
+func (suite *LifecycleSuite) TestInstanceNotDetected() {
+	// Arrange
+	log := &lifecycleLog{}
+	c := NewContainer(WithService[Starter](&lifecycleService{name: "a", log: log}))
+
+	// Act
+	err := c.Start(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(log.events)
+}
+
+// TestAlreadyStarted tests the Container can not be started twice
+func (suite *LifecycleSuite) TestAlreadyStarted() {
+	// Arrange
+	c := NewContainer()
+
+	// Act
+	err1 := c.Start(context.Background())
+	err2 := c.Start(context.Background())
+
+	// Assert
+	suite.NoError(err1)
+	suite.ErrorIs(err2, ErrAlreadyStarted)
+}
+
+// TestClose tests the created services are closed in the reverse creation order
+// and the instances are not closed
+func (suite *LifecycleSuite) TestClose() {
+	// Arrange
+	log := &lifecycleLog{}
+	c := NewContainer(
+		WithValue(&closerService{name: "value", log: log}),
+		WithFactory(func(closerA) closerB {
+			return closerB{&closerService{name: "b", log: log}}
+		}),
+		WithFactory(func() closerA {
+			return closerA{&closerService{name: "a", log: log}}
+		}),
+	)
+	_, getErr := GetService[closerB](c)
+
+	// Act
+	err1 := c.Close()
+	err2 := c.Close()
+
+	// Assert
+	suite.NoError(getErr)
+	suite.NoError(err1)
+	suite.NoError(err2)
+	suite.Equal([]string{"close b", "close a"}, log.events)
+}
+
+// TestLifecycle tests the Container lifecycle
+func TestLifecycle(t *testing.T) {
+	suite.Run(t, new(LifecycleSuite))
+}
@@ -0,0 +1,100 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"reflect"
+)
+
+// Lifetime defines how long the service instance created by the factory lives
+type Lifetime int
+
+const (
+	// Singleton services are created once per Container.
+	//
+	// This is the default lifetime
+	Singleton Lifetime = iota
+
+	// Scoped services are created once per scope
+	// and closed when the scope is closed.
+	//
+	// Can not be resolved from the root Container
+	Scoped
+
+	// Transient services are created every time they are resolved
+	// and closed when the resolving scope is closed.
+	//
+	// The Transient services resolved from the root Container are never closed
+	Transient
+)
+
+// lifetimeOption sets the service Lifetime
+type lifetimeOption struct {
+	lifetime Lifetime
+}
+
+// applyService applies the ServiceOption
+func (opt *lifetimeOption) applyService(accessor *serviceAccessor) {
+	accessor.lifetime = opt.lifetime
+}
+
+// WithLifetime sets the Lifetime of the service added with a factory.
+//
+// Has no effect for the services added with an instance
+func WithLifetime(lifetime Lifetime) ServiceOption {
+	return &lifetimeOption{
+		lifetime: lifetime,
+	}
+}
+
+// instance returns the accessor's service instance for the accessor's lifetime
+// resolving the Scoped and Transient services dependencies from the Container
+func (c *Container) instance(ctx context.Context, accessor *serviceAccessor) (reflect.Value, error) {
+	accessor.markResolved()
+
+	if accessor.factory == nil {
+		return accessor.Instance(ctx)
+	}
+
+	switch accessor.lifetime {
+	case Scoped:
+		scoped, err := c.scopedAccessor(accessor)
+		if err != nil {
+			return reflect.Zero(accessor.id.Type), err
+		}
+
+		return scoped.Instance(ctx)
+	case Transient:
+		return accessor.cloneTo(c).Instance(ctx)
+	default:
+		return accessor.Instance(ctx)
+	}
+}
+
+// singletons returns the Container accessors of the Singleton services
+// and the services added with an instance in the registration order
+func (c *Container) singletons() []*serviceAccessor {
+	singletons := make([]*serviceAccessor, 0, len(c.registrations))
+	for _, accessor := range c.registrations {
+		if accessor.factory == nil || accessor.lifetime == Singleton {
+			singletons = append(singletons, accessor)
+		}
+	}
+
+	return singletons
+}
+
+// cloneTo returns a new accessor with the same service registration
+// resolving the factory dependencies from the provided Container
+func (accessor *serviceAccessor) cloneTo(c *Container) *serviceAccessor {
+	clone := newServiceAccessor(accessor.id, c, accessor.factory, nil)
+	clone.policy = accessor.policy
+	clone.lifetime = accessor.lifetime
+	clone.onStart = accessor.onStart
+	clone.onStop = accessor.onStop
+
+	return clone
+}
@@ -0,0 +1,33 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+// Options is a typed configuration resolvable from the Container.
+//
+// Factories declare the Options[T] parameter to receive the configuration of type T
+type Options[T any] struct {
+	// Value is the configuration value
+	Value T
+}
+
+// NewOptions creates a new Options with the provided value
+func NewOptions[T any](value T) Options[T] {
+	return Options[T]{
+		Value: value,
+	}
+}
+
+// Validate validates the Options value if it implements the Validator interface
+func (opts Options[T]) Validate() error {
+	if v, ok := any(opts.Value).(Validator); ok {
+		return v.Validate()
+	}
+
+	if v, ok := any(&opts.Value).(Validator); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"log"
+	"reflect"
+)
+
+// provideOption adds a new service with the typed factory to the Container
+type provideOption struct {
+	factory *serviceFactory
+	svcOpts []ServiceOption
+}
+
+// apply applies the Option
+func (opt *provideOption) apply(c *Container) {
+	id := newServiceIdentifier(opt.factory.ReturnType, nil)
+	accessor := newServiceAccessor(id, c, opt.factory, nil)
+	accessor.applyOptions(opt.svcOpts)
+	c.appendAccessor(id, accessor)
+}
+
+// newTypedServiceFactory creates a new serviceFactory calling the typed factory function directly
+func newTypedServiceFactory[T any](factory any, call func(deps []reflect.Value) (T, error)) *serviceFactory {
+	if reflect.ValueOf(factory).IsNil() {
+		log.Panicf("[%v]: service factory must not be nil\n", reflect.TypeFor[T]())
+	}
+
+	f := newServiceFactory(factory)
+	f.call = func(deps []reflect.Value) (reflect.Value, error) {
+		svc, err := call(deps)
+		if err != nil {
+			return reflect.Zero(f.ReturnType), err
+		}
+
+		return reflect.ValueOf(&svc).Elem(), nil
+	}
+
+	return f
+}
+
+// provide returns a new instance of provideOption
+func provide[T any](factory any, svcOpts []ServiceOption, call func(deps []reflect.Value) (T, error)) Option {
+	return &provideOption{
+		factory: newTypedServiceFactory(factory, call),
+		svcOpts: svcOpts,
+	}
+}
+
+// dep returns the resolved dependency of type D
+func dep[D any](v reflect.Value) D {
+	d, _ := v.Interface().(D)
+	return d
+}
+
+// Provide0 adds a new service to the Container with the typed factory without dependencies.
+// Unlike the WithFactory, the factory signature is checked by the compiler
+// and the factory is called directly without the reflection
+func Provide0[T any](factory func() (T, error), svcOpts ...ServiceOption) Option {
+	return provide(factory, svcOpts, func([]reflect.Value) (T, error) {
+		return factory()
+	})
+}
+
+// Provide1 adds a new service to the Container with the typed factory of one dependency
+func Provide1[T, A any](factory func(A) (T, error), svcOpts ...ServiceOption) Option {
+	return provide(factory, svcOpts, func(deps []reflect.Value) (T, error) {
+		return factory(dep[A](deps[0]))
+	})
+}
+
+// Provide2 adds a new service to the Container with the typed factory of two dependencies
+func Provide2[T, A, B any](factory func(A, B) (T, error), svcOpts ...ServiceOption) Option {
+	return provide(factory, svcOpts, func(deps []reflect.Value) (T, error) {
+		return factory(dep[A](deps[0]), dep[B](deps[1]))
+	})
+}
+
+// Provide3 adds a new service to the Container with the typed factory of three dependencies
+func Provide3[T, A, B, C any](factory func(A, B, C) (T, error), svcOpts ...ServiceOption) Option {
+	return provide(factory, svcOpts, func(deps []reflect.Value) (T, error) {
+		return factory(dep[A](deps[0]), dep[B](deps[1]), dep[C](deps[2]))
+	})
+}
+
+// Provide4 adds a new service to the Container with the typed factory of four dependencies
+func Provide4[T, A, B, C, D any](factory func(A, B, C, D) (T, error), svcOpts ...ServiceOption) Option {
+	return provide(factory, svcOpts, func(deps []reflect.Value) (T, error) {
+		return factory(dep[A](deps[0]), dep[B](deps[1]), dep[C](deps[2]), dep[D](deps[3]))
+	})
+}
+
+// Provide5 adds a new service to the Container with the typed factory of five dependencies
+func Provide5[T, A, B, C, D, E any](factory func(A, B, C, D, E) (T, error), svcOpts ...ServiceOption) Option {
+	return provide(factory, svcOpts, func(deps []reflect.Value) (T, error) {
+		return factory(dep[A](deps[0]), dep[B](deps[1]), dep[C](deps[2]), dep[D](deps[3]), dep[E](deps[4]))
+	})
+}
+
+// Provide6 adds a new service to the Container with the typed factory of six dependencies
+func Provide6[T, A, B, C, D, E, F any](factory func(A, B, C, D, E, F) (T, error), svcOpts ...ServiceOption) Option {
+	return provide(factory, svcOpts, func(deps []reflect.Value) (T, error) {
+		return factory(dep[A](deps[0]), dep[B](deps[1]), dep[C](deps[2]), dep[D](deps[3]), dep[E](deps[4]), dep[F](deps[5]))
+	})
+}
@@ -0,0 +1,221 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"reflect"
+	"testing"
+)
+
+// provideNamer is the interface dependency used in the Provide tests
+type provideNamer interface {
+	Name() string
+}
+
+// provideName implements the provideNamer interface
+type provideName string
+
+// Name implements the provideNamer interface
+func (n provideName) Name() string {
+	return string(n)
+}
+
+// provideService is the service created in the Provide tests
+type provideService struct {
+	deps []any
+}
+
+// ProvideSuite is the suite for testing the Provide functions
+type ProvideSuite struct {
+	suite.Suite
+}
+
+// TestDependencies tests that the factories receive the resolved dependencies
+func (suite *ProvideSuite) TestDependencies() {
+	// Arrange
+	c := NewContainer(
+		WithValue(1),
+		WithValue("str"),
+		WithValue(true),
+		WithValue(1.5),
+		WithValue[provideNamer](provideName("name")),
+		WithValue(int8(2)),
+		Provide6(func(i int, s string, b bool, f float64, n provideNamer, i8 int8) (*provideService, error) {
+			return &provideService{deps: []any{i, s, b, f, n.Name(), i8}}, nil
+		}),
+	)
+
+	// Act
+	res, err := GetService[*provideService](c)
+
+	// Assert
+	suite.Require().NoError(err)
+	suite.Equal([]any{1, "str", true, 1.5, "name", int8(2)}, res.deps)
+	suite.Same(res, MustGetService[*provideService](c))
+}
+
+// TestArities tests the factories of every dependencies count
+func (suite *ProvideSuite) TestArities() {
+	// Arrange
+	c := NewContainer(
+		WithValue(1),
+		Provide0(func() (int8, error) { return 1, nil }),
+		Provide1(func(i int) (int16, error) { return int16(i) + 1, nil }),
+		Provide2(func(i int, a int16) (int32, error) { return int32(i) + int32(a), nil }),
+		Provide3(func(i int, a int16, b int32) (int64, error) { return int64(i) + int64(a) + int64(b), nil }),
+		Provide4(func(i int, _ int16, _ int32, d int64) (uint, error) { return uint(i) + uint(d), nil }),
+		Provide5(func(_ int, _ int16, _ int32, _ int64, u uint) (uint8, error) { return uint8(u), nil }),
+	)
+
+	// Act & Assert
+	suite.Equal(int8(1), MustGetService[int8](c))
+	suite.Equal(int16(2), MustGetService[int16](c))
+	suite.Equal(int32(3), MustGetService[int32](c))
+	suite.Equal(int64(6), MustGetService[int64](c))
+	suite.Equal(uint(7), MustGetService[uint](c))
+	suite.Equal(uint8(7), MustGetService[uint8](c))
+}
+
+// TestInterfaceService tests that the interface service is registered by the factory type parameter
+func (suite *ProvideSuite) TestInterfaceService() {
+	// Arrange
+	c := NewContainer(
+		Provide0(func() (provideNamer, error) {
+			return provideName("name"), nil
+		}),
+	)
+
+	// Act
+	res, err := GetService[provideNamer](c)
+
+	// Assert
+	suite.Require().NoError(err)
+	suite.Equal("name", res.Name())
+}
+
+// TestContext tests that the factory receives the resolving context
+func (suite *ProvideSuite) TestContext() {
+	// Arrange
+	type ctxKey struct{}
+
+	c := NewContainer(
+		Provide1(func(ctx context.Context) (string, error) {
+			return ctx.Value(ctxKey{}).(string), nil
+		}),
+	)
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	// Act
+	res, err := GetServiceCtx[string](ctx, c)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal("value", res)
+}
+
+// TestFactoryError tests that the factory error is returned
+func (suite *ProvideSuite) TestFactoryError() {
+	// Arrange
+	factoryErr := errors.New("factory error")
+	c := NewContainer(
+		Provide0(func() (*provideService, error) {
+			return nil, factoryErr
+		}),
+	)
+
+	// Act
+	_, err := GetService[*provideService](c)
+
+	// Assert
+	suite.ErrorIs(err, factoryErr)
+}
+
+// TestMissingDependency tests that the missing dependency is reported with the DependencyError
+func (suite *ProvideSuite) TestMissingDependency() {
+	// Arrange
+	c := NewContainer(
+		Provide1(func(s string) (*provideService, error) {
+			return &provideService{}, nil
+		}),
+	)
+
+	// Act
+	_, err := GetService[*provideService](c)
+
+	// Assert
+	var depErr *DependencyError
+	if suite.ErrorAs(err, &depErr) {
+		suite.Equal(reflect.TypeFor[string](), depErr.DependencyType)
+		suite.ErrorIs(depErr, ErrServiceNotFound)
+	}
+}
+
+// TestLifetime tests that the service options are applied
+func (suite *ProvideSuite) TestLifetime() {
+	// Arrange
+	c := NewContainer(
+		Provide0(func() (*provideService, error) {
+			return &provideService{}, nil
+		}, WithLifetime(Transient)),
+	)
+
+	// Act
+	res1 := MustGetService[*provideService](c)
+	res2 := MustGetService[*provideService](c)
+
+	// Assert
+	suite.NotSame(res1, res2)
+}
+
+// TestNilFactory tests that the nil factory panics
+func (suite *ProvideSuite) TestNilFactory() {
+	// Act & Assert
+	suite.Panics(func() {
+		Provide0[*provideService](nil)
+	})
+}
+
+// TestProvide runs the ProvideSuite
+func TestProvide(t *testing.T) {
+	suite.Run(t, new(ProvideSuite))
+}
+
+// BenchmarkProvide benchmarks creating the Transient service with the typed factory
+func BenchmarkProvide(b *testing.B) {
+	c := NewContainer(
+		WithValue(1),
+		WithValue("str"),
+		Provide2(func(i int, s string) (*provideService, error) {
+			return &provideService{}, nil
+		}, WithLifetime(Transient)),
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetService[*provideService](c)
+	}
+}
+
+// BenchmarkWithFactory benchmarks creating the Transient service with the reflection factory
+func BenchmarkWithFactory(b *testing.B) {
+	c := NewContainer(
+		WithValue(1),
+		WithValue("str"),
+		WithFactory(func(i int, s string) (*provideService, error) {
+			return &provideService{}, nil
+		}, WithLifetime(Transient)),
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetService[*provideService](c)
+	}
+}
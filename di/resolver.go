@@ -0,0 +1,91 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Resolver resolves the services without the Container,
+// e.g. the containers generated with the gonet gen command
+type Resolver interface {
+	// Resolve returns the service instance for the provided type and the key if keyed is true.
+	// The slice type requests all the services of the slice element type.
+	//
+	// Returns the ErrServiceNotFound error if the service is not registered
+	Resolve(ctx context.Context, typ reflect.Type, key string, keyed bool) (any, error)
+}
+
+// resolverGetter is the ServiceGetter resolving the services with the Resolver
+type resolverGetter struct {
+	resolver Resolver
+}
+
+// NewServiceGetter returns the ServiceGetter resolving the services with the provided Resolver,
+// so the services can be resolved with the GetService functions
+func NewServiceGetter(resolver Resolver) ServiceGetter {
+	return &resolverGetter{
+		resolver: resolver,
+	}
+}
+
+// getService implements the ServiceGetter interface
+func (g *resolverGetter) getService(ctx context.Context, id serviceIdentifier) (reflect.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return reflect.Zero(id.Type), err
+	}
+
+	instance, err := g.resolver.Resolve(ctx, id.Type, id.Key, id.HasKey)
+	if err != nil || instance == nil {
+		return reflect.Zero(id.Type), err
+	}
+
+	val := reflect.ValueOf(instance)
+	if !val.Type().AssignableTo(id.Type) {
+		return reflect.Zero(id.Type), fmt.Errorf("di: resolver returned %q for the %q service", val.Type(), id)
+	}
+
+	return val, nil
+}
+
+// fallbackOption adds the fallback Resolver to the Container
+type fallbackOption struct {
+	resolver Resolver
+}
+
+// apply applies the Option
+func (opt *fallbackOption) apply(c *Container) {
+	c.fallbacks = append(c.fallbacks, opt.resolver)
+}
+
+// WithFallback adds the Resolver resolving the services not added to the Container,
+// e.g. the fakes in the tests.
+// The fallback resolvers are called in the order they are added
+// until one of them returns the result other than the ErrServiceNotFound error.
+// The scopes use the fallback resolvers of their parent Containers
+func WithFallback(resolver Resolver) Option {
+	return &fallbackOption{
+		resolver: resolver,
+	}
+}
+
+// resolveFallback resolves the service not added to the Container with the fallback resolvers
+//
+// Returns ErrServiceNotFound if none of the resolvers resolved the service
+func (c *Container) resolveFallback(ctx context.Context, id serviceIdentifier) (reflect.Value, error) {
+	for cur := c; cur != nil; cur = cur.parent {
+		for _, resolver := range cur.fallbacks {
+			val, err := NewServiceGetter(resolver).getService(ctx, id)
+			if !errors.Is(err, ErrServiceNotFound) {
+				return val, err
+			}
+		}
+	}
+
+	return reflect.Zero(id.Type), ErrServiceNotFound
+}
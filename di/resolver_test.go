@@ -0,0 +1,146 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"reflect"
+	"testing"
+)
+
+// mapResolver is the Resolver resolving the services from the map used in the tests
+type mapResolver map[reflect.Type]any
+
+// Resolve implements the Resolver interface
+func (r mapResolver) Resolve(ctx context.Context, typ reflect.Type, key string, keyed bool) (any, error) {
+	instance, ok := r[typ]
+	if !ok || keyed {
+		return nil, ErrServiceNotFound
+	}
+
+	return instance, nil
+}
+
+// ResolverSuite is the suite for testing the NewServiceGetter function
+type ResolverSuite struct {
+	suite.Suite
+	sg ServiceGetter
+}
+
+// SetupTest creates the ServiceGetter with the mapResolver
+func (suite *ResolverSuite) SetupTest() {
+	suite.sg = NewServiceGetter(mapResolver{
+		reflect.TypeFor[string]():       "test",
+		reflect.TypeFor[fmt.Stringer](): nil,
+		reflect.TypeFor[int]():          "invalid",
+	})
+}
+
+// TestResolve tests that the service is resolved with the Resolver
+func (suite *ResolverSuite) TestResolve() {
+	// Act
+	res, err := GetService[string](suite.sg)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal("test", res)
+}
+
+// TestNotFound tests that the Resolver errors are returned
+func (suite *ResolverSuite) TestNotFound() {
+	// Act
+	_, err := GetKeyedService[string](suite.sg, "key")
+
+	// Assert
+	suite.ErrorIs(err, ErrServiceNotFound)
+}
+
+// TestNilInstance tests that the nil instance is resolved as the zero value
+func (suite *ResolverSuite) TestNilInstance() {
+	// Act
+	res, err := GetService[fmt.Stringer](suite.sg)
+
+	// Assert
+	suite.NoError(err)
+	suite.Nil(res)
+}
+
+// TestInvalidType tests that the instance of the wrong type is rejected
+func (suite *ResolverSuite) TestInvalidType() {
+	// Act
+	_, err := GetService[int](suite.sg)
+
+	// Assert
+	suite.Error(err)
+}
+
+// TestResolver runs the ResolverSuite
+func TestResolver(t *testing.T) {
+	suite.Run(t, new(ResolverSuite))
+}
+
+// WithFallbackSuite is the suite for testing the WithFallback option
+type WithFallbackSuite struct {
+	suite.Suite
+	c *Container
+}
+
+// SetupTest creates the Container with the fallback resolvers
+func (suite *WithFallbackSuite) SetupTest() {
+	suite.c = NewContainer(
+		WithValue("registered"),
+		WithFallback(mapResolver{
+			reflect.TypeFor[string](): "fallback",
+			reflect.TypeFor[int]():    1,
+		}),
+		WithFallback(mapResolver{
+			reflect.TypeFor[int]():     2,
+			reflect.TypeFor[float64](): 1.5,
+		}),
+		WithFactory(func(i int) *int {
+			return &i
+		}),
+	)
+}
+
+// TestRegistered tests that the registered services are resolved without the fallbacks
+func (suite *WithFallbackSuite) TestRegistered() {
+	// Act & Assert
+	suite.Equal("registered", MustGetService[string](suite.c))
+}
+
+// TestFallback tests that the fallbacks are called in the order they are added
+func (suite *WithFallbackSuite) TestFallback() {
+	// Act & Assert
+	suite.Equal(1, MustGetService[int](suite.c))
+	suite.Equal(1.5, MustGetService[float64](suite.c))
+	suite.Equal(1, *MustGetService[*int](suite.c))
+}
+
+// TestScope tests that the scopes use the parent fallbacks
+func (suite *WithFallbackSuite) TestScope() {
+	// Arrange
+	scope := suite.c.NewScope()
+	defer scope.Close()
+
+	// Act & Assert
+	suite.Equal(1.5, MustGetService[float64](scope))
+}
+
+// TestNotFound tests that the service not resolved by the fallbacks is not found
+func (suite *WithFallbackSuite) TestNotFound() {
+	// Act
+	_, err := GetKeyedService[int](suite.c, "key")
+
+	// Assert
+	suite.ErrorIs(err, ErrServiceNotFound)
+}
+
+// TestWithFallback runs the WithFallbackSuite
+func TestWithFallback(t *testing.T) {
+	suite.Run(t, new(WithFallbackSuite))
+}
@@ -0,0 +1,71 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import "errors"
+
+var (
+	// ErrScopeRequired is the error returned when a Scoped service is requested from the root Container
+	ErrScopeRequired = errors.New("di: scoped service requested outside of a scope")
+)
+
+// NewScope creates a new scope of the Container.
+//
+// The scope is a Container resolving the services registered in the scope itself
+// and then in its parent Containers. The Singleton services are shared with the parent,
+// the Scoped services are created once per scope.
+// The provided options register the services living only in the scope,
+// e.g. the per-request values.
+//
+// The scope must be closed with the Container.Close method
+// to dispose the Scoped and Transient services created by the scope
+func (c *Container) NewScope(opts ...Option) *Container {
+	scope := &Container{
+		accessors:        make(serviceAccessors),
+		startConcurrency: c.startConcurrency,
+		parent:           c,
+		scoped:           make(map[*serviceAccessor]*serviceAccessor),
+	}
+
+	scope.applyOptions(opts)
+
+	return scope
+}
+
+// lookupAccessors returns the accessors list for the provided id
+// registered in the Container or in the closest parent Container
+func (c *Container) lookupAccessors(id serviceIdentifier) (*serviceAccessorsList, bool) {
+	for cur := c; cur != nil; cur = cur.parent {
+		if accessors, ok := cur.accessors[id]; ok {
+			return accessors, true
+		}
+	}
+
+	return nil, false
+}
+
+// scopedAccessor returns the scope's own accessor for the Scoped service accessor
+//
+// Returns ErrScopeRequired for the root Container
+func (c *Container) scopedAccessor(accessor *serviceAccessor) (*serviceAccessor, error) {
+	if c.parent == nil {
+		return nil, ErrScopeRequired
+	}
+
+	if accessor.cont == c {
+		return accessor, nil
+	}
+
+	c.scopedMu.Lock()
+	defer c.scopedMu.Unlock()
+
+	scoped, ok := c.scoped[accessor]
+	if !ok {
+		scoped = accessor.cloneTo(c)
+		c.scoped[accessor] = scoped
+	}
+
+	return scoped, nil
+}
@@ -0,0 +1,180 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type (
+	// scopedService is the Scoped service used in the tests
+	scopedService struct {
+		closed bool
+	}
+
+	// transientService is the Transient service used in the tests
+	transientService struct {
+		id int
+	}
+
+	// singletonService is the Singleton service used in the tests
+	singletonService struct {
+		id int
+	}
+)
+
+// Close implements the io.Closer interface
+func (s *scopedService) Close() error {
+	s.closed = true
+	return nil
+}
+
+// ScopeSuite is the suite for testing the Container scopes
+type ScopeSuite struct {
+	suite.Suite
+}
+
+// newContainer creates a new Container with the services of every lifetime
+func (suite *ScopeSuite) newContainer() *Container {
+	return NewContainer(
+		WithFactory(func() *scopedService { return &scopedService{} }, WithLifetime(Scoped)),
+		WithFactory(func() *transientService { return &transientService{} }, WithLifetime(Transient)),
+		WithFactory(func() *singletonService { return &singletonService{} }),
+	)
+}
+
+// TestScoped tests the Scoped service is created once per scope
+func (suite *ScopeSuite) TestScoped() {
+	// Arrange
+	c := suite.newContainer()
+	scope1, scope2 := c.NewScope(), c.NewScope()
+
+	// Act
+	res1, err1 := GetService[*scopedService](scope1)
+	res2, err2 := GetService[*scopedService](scope1)
+	res3, err3 := GetService[*scopedService](scope2)
+
+	// Assert
+	suite.NoError(err1)
+	suite.NoError(err2)
+	suite.NoError(err3)
+	suite.Same(res1, res2)
+	suite.NotSame(res1, res3)
+}
+
+// TestScopedFromRoot tests the Scoped service can not be resolved from the root Container
+func (suite *ScopeSuite) TestScopedFromRoot() {
+	// Arrange
+	c := suite.newContainer()
+
+	// Act
+	_, err := GetService[*scopedService](c)
+
+	// Assert
+	suite.ErrorIs(err, ErrScopeRequired)
+}
+
+// TestTransient tests the Transient service is created on every resolve
+func (suite *ScopeSuite) TestTransient() {
+	// Arrange
+	c := suite.newContainer()
+
+	// Act
+	res1, err1 := GetService[*transientService](c)
+	res2, err2 := GetService[*transientService](c)
+
+	// Assert
+	suite.NoError(err1)
+	suite.NoError(err2)
+	suite.NotSame(res1, res2)
+}
+
+// TestSingleton tests the Singleton service is shared with the scopes
+func (suite *ScopeSuite) TestSingleton() {
+	// Arrange
+	c := suite.newContainer()
+
+	// Act
+	res1, err1 := GetService[*singletonService](c)
+	res2, err2 := GetService[*singletonService](c.NewScope())
+
+	// Assert
+	suite.NoError(err1)
+	suite.NoError(err2)
+	suite.Same(res1, res2)
+}
+
+// TestScopeOptions tests the scope services override the parent ones
+// and are not visible in the parent
+func (suite *ScopeSuite) TestScopeOptions() {
+	// Arrange
+	c := NewContainer(WithValue("root"))
+	scope := c.NewScope(WithValue("scope"))
+
+	// Act
+	res1, err1 := GetService[string](scope)
+	res2, err2 := GetService[string](c)
+	sg, err3 := GetService[ServiceGetter](scope)
+
+	// Assert
+	suite.NoError(err1)
+	suite.NoError(err2)
+	suite.NoError(err3)
+	suite.Equal("scope", res1)
+	suite.Equal("root", res2)
+	suite.Same(scope, sg)
+}
+
+// TestClose tests the scope closes its Scoped services only
+func (suite *ScopeSuite) TestClose() {
+	// Arrange
+	c := NewContainer(
+		WithFactory(func() *scopedService { return &scopedService{} }, WithLifetime(Scoped)),
+		WithKeyedFactory("singleton", func() *scopedService { return &scopedService{} }),
+	)
+	scope := c.NewScope()
+	scoped, _ := GetService[*scopedService](scope)
+	singleton, _ := GetKeyedService[*scopedService](scope, "singleton")
+
+	// Act
+	err := scope.Close()
+
+	// Assert
+	suite.NoError(err)
+	suite.True(scoped.closed)
+	suite.False(singleton.closed)
+}
+
+// TestTrackCreated tests only the closable services are tracked
+// and the root Container never tracks the Transient services
+func (suite *ScopeSuite) TestTrackCreated() {
+	// Arrange
+	c := NewContainer(
+		WithFactory(func() *transientService { return &transientService{} }, WithLifetime(Transient)),
+		WithFactory(func() *scopedService { return &scopedService{} }, WithLifetime(Transient)),
+	)
+	scope := c.NewScope()
+
+	// Act
+	for range 1000 {
+		MustGetService[*transientService](c)
+		MustGetService[*scopedService](c)
+		MustGetService[*transientService](scope)
+	}
+	closable := MustGetService[*scopedService](scope)
+
+	// Assert
+	suite.Empty(c.created)
+	suite.Len(scope.created, 1)
+
+	suite.NoError(scope.Close())
+	suite.True(closable.closed)
+}
+
+// TestScope tests the Container scopes
+func TestScope(t *testing.T) {
+	suite.Run(t, new(ScopeSuite))
+}
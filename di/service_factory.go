@@ -20,11 +20,19 @@ type serviceFactory struct {
 	// DepsCount is a number of the factory dependencies
 	DepsCount int
 
+	// DepTypes are the factory dependencies types
+	// precomputed so the dependencies are resolved without inspecting the factory type
+	DepTypes []reflect.Type
+
 	// ReturnType is the return type of the factory function
 	ReturnType reflect.Type
 
 	// HasErr is true if the factory returns an error as the second return argument
 	HasErr bool
+
+	// call calls the typed factory function directly without the reflection call,
+	// nil for the factories added with the any type
+	call func(deps []reflect.Value) (reflect.Value, error)
 }
 
 // newServiceFactory creates a new serviceFactory for the provided factory function
@@ -49,10 +57,16 @@ func newServiceFactory(factory any) *serviceFactory {
 		log.Panicf("[%t]: service factory returns too many values\n", factory)
 	}
 
+	depTypes := make([]reflect.Type, typ.NumIn())
+	for i := range depTypes {
+		depTypes[i] = typ.In(i)
+	}
+
 	return &serviceFactory{
 		Type:       typ,
 		Value:      val,
 		DepsCount:  typ.NumIn(),
+		DepTypes:   depTypes,
 		ReturnType: typ.Out(0),
 		HasErr:     numOut == 2,
 	}
@@ -60,6 +74,10 @@ func newServiceFactory(factory any) *serviceFactory {
 
 // Call calls the factory function with the provided dependencies
 func (factory *serviceFactory) Call(deps ...reflect.Value) (reflect.Value, error) {
+	if factory.call != nil {
+		return factory.call(deps)
+	}
+
 	values := factory.Value.Call(deps)
 	if factory.HasErr && !values[1].IsNil() {
 		return reflect.Zero(factory.ReturnType), values[1].Interface().(error)
@@ -4,10 +4,14 @@
 
 package di
 
-import "reflect"
+import (
+	"context"
+	"reflect"
+)
 
 // ServiceGetter is an interface for getting a service
 type ServiceGetter interface {
 	// getService gets a service instance for the provided service identifier
-	getService(id serviceIdentifier) (reflect.Value, error)
+	// resolving it with the provided context
+	getService(ctx context.Context, id serviceIdentifier) (reflect.Value, error)
 }
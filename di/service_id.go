@@ -4,7 +4,10 @@
 
 package di
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+)
 
 // serviceIdentifier stores the service type and key
 type serviceIdentifier struct {
@@ -32,3 +35,12 @@ func newServiceIdentifier(typ reflect.Type, key *string) serviceIdentifier {
 
 	return id
 }
+
+// String returns the service type and the key if the service is keyed
+func (id serviceIdentifier) String() string {
+	if id.HasKey {
+		return fmt.Sprintf("%v:%s", id.Type, id.Key)
+	}
+
+	return id.Type.String()
+}
@@ -0,0 +1,37 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+// ServiceOption is an interface configuring a single service registration
+type ServiceOption interface {
+	applyService(*serviceAccessor)
+}
+
+// failurePolicyOption sets the service FailurePolicy
+type failurePolicyOption struct {
+	policy FailurePolicy
+}
+
+// applyService applies the ServiceOption
+func (opt *failurePolicyOption) applyService(accessor *serviceAccessor) {
+	accessor.policy = opt.policy
+}
+
+// WithFailurePolicy sets the FailurePolicy used when the service factory returns an error.
+//
+// Has no effect for the services added with an instance
+func WithFailurePolicy(policy FailurePolicy) ServiceOption {
+	return &failurePolicyOption{
+		policy: policy,
+	}
+}
+
+// builtinOption marks the service added to every Container by default
+type builtinOption struct{}
+
+// applyService applies the ServiceOption
+func (opt *builtinOption) applyService(accessor *serviceAccessor) {
+	accessor.builtin = true
+}
@@ -0,0 +1,205 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"errors"
+	"log"
+	"reflect"
+	"slices"
+)
+
+var (
+	// ErrForeignSnapshot is the error returned when the Snapshot is restored to another Container
+	ErrForeignSnapshot = errors.New("di: snapshot was taken from another container")
+)
+
+// accessorState is the accessor's service instance state
+type accessorState struct {
+	instance *reflect.Value
+	err      error
+	boxed    any
+	ready    bool
+}
+
+// state returns the accessor's service instance state
+func (accessor *serviceAccessor) state() accessorState {
+	accessor.mu.Lock()
+	defer accessor.mu.Unlock()
+
+	return accessorState{
+		instance: accessor.instance,
+		err:      accessor.err,
+		boxed:    accessor.boxed,
+		ready:    accessor.ready.Load(),
+	}
+}
+
+// setState sets the accessor's service instance state
+func (accessor *serviceAccessor) setState(state accessorState) {
+	accessor.mu.Lock()
+	defer accessor.mu.Unlock()
+
+	accessor.instance, accessor.err, accessor.boxed = state.instance, state.err, state.boxed
+	accessor.ready.Store(state.ready)
+}
+
+// copyTo returns a copy of the accessor with the same service registration
+// and the service instance state for the provided Container
+func (accessor *serviceAccessor) copyTo(c *Container) *serviceAccessor {
+	cp := accessor.cloneTo(c)
+	cp.validateOnBuild = accessor.validateOnBuild
+	cp.setState(accessor.state())
+	cp.resolved.Store(accessor.resolved.Load())
+
+	return cp
+}
+
+// Clone returns a copy of the Container with the provided options applied,
+// e.g. the WithOverride options replacing the services for a single test.
+//
+// The clone shares the service instances already created by the Container,
+// they are neither created again nor closed by the clone.
+// The instances depending on the services added or replaced by the options
+// or on the ServiceGetter and the Container, directly or transitively,
+// are not shared and are created again by the clone.
+// The services not created yet are created by the clone independently from the Container
+// and resolve their dependencies from the clone.
+// The clone is not started even if the Container is.
+//
+// Panics with the ValidationError message if any of the services
+// marked with the ValidateOnBuild option is invalid
+func (c *Container) Clone(opts ...Option) *Container {
+	clone := &Container{
+		accessors:        make(serviceAccessors),
+		startConcurrency: c.startConcurrency,
+		fallbacks:        slices.Clone(c.fallbacks),
+		parent:           c.parent,
+	}
+
+	if c.parent != nil {
+		clone.scoped = make(map[*serviceAccessor]*serviceAccessor)
+	}
+
+	copied := make(map[*serviceAccessor]bool, len(c.registrations))
+	for _, accessor := range c.registrations {
+		if !accessor.builtin {
+			cp := accessor.copyTo(clone)
+			copied[cp] = true
+			clone.appendAccessor(accessor.id, cp)
+		}
+	}
+
+	clone.applyOptions(opts)
+	clone.resetDependents(copied)
+
+	if err := clone.validate(); err != nil {
+		log.Panic(err)
+	}
+
+	return clone
+}
+
+// resetDependents resets the instance state of the copied accessors depending
+// on the accessors added by the Clone options or the clone builtin accessors, directly or transitively,
+// so the clone creates them again with the added services resolving from the clone
+func (c *Container) resetDependents(copied map[*serviceAccessor]bool) {
+	g := newDependencyGraph(c, c.registrations)
+
+	var added []*serviceAccessor
+	for _, accessor := range c.registrations {
+		if !copied[accessor] {
+			added = append(added, accessor)
+		}
+	}
+
+	visited := make(map[*serviceAccessor]bool)
+	for len(added) > 0 {
+		node := added[len(added)-1]
+		added = added[:len(added)-1]
+
+		for _, dependent := range g.dependents[node] {
+			if visited[dependent] {
+				continue
+			}
+
+			visited[dependent] = true
+			if copied[dependent] {
+				dependent.setState(accessorState{})
+			}
+
+			added = append(added, dependent)
+		}
+	}
+}
+
+// Snapshot is the state of the Container service instances
+// restored with the Container.Restore method
+type Snapshot struct {
+	// cont is the Container the Snapshot was taken from
+	cont *Container
+
+	// states are the Container accessors states
+	states map[*serviceAccessor]accessorState
+
+	// created are the accessors created by the Container before the Snapshot was taken
+	created []*serviceAccessor
+}
+
+// Snapshot returns the current state of the Container service instances
+func (c *Container) Snapshot() *Snapshot {
+	s := &Snapshot{
+		cont:   c,
+		states: make(map[*serviceAccessor]accessorState, len(c.registrations)),
+	}
+
+	for _, accessor := range c.registrations {
+		s.states[accessor] = accessor.state()
+	}
+
+	c.createdMu.Lock()
+	s.created = slices.Clone(c.created)
+	c.createdMu.Unlock()
+
+	return s
+}
+
+// Restore rolls the Container service instances back to the Snapshot state.
+//
+// The services created after the Snapshot was taken are closed like with the Container.Close method
+// and are created again when requested. The services created before are not touched.
+// The started services are not stopped.
+// Must not be called concurrently with the services resolution.
+//
+// Returns ErrForeignSnapshot if the Snapshot was taken from another Container
+// or the joined errors of the closed services
+func (c *Container) Restore(s *Snapshot) error {
+	if s.cont != c {
+		return ErrForeignSnapshot
+	}
+
+	before := make(map[*serviceAccessor]bool, len(s.created))
+	for _, accessor := range s.created {
+		before[accessor] = true
+	}
+
+	c.createdMu.Lock()
+	var closing []*serviceAccessor
+	for _, accessor := range c.created {
+		if !before[accessor] {
+			closing = append(closing, accessor)
+		}
+	}
+	c.created = slices.Clone(s.created)
+	c.createdMu.Unlock()
+
+	err := closeCreated(closing)
+
+	for _, accessor := range c.registrations {
+		accessor.setState(s.states[accessor])
+	}
+
+	return err
+}
@@ -0,0 +1,226 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+// snapshotResource is the closable service used in the Clone and Snapshot tests
+type snapshotResource struct {
+	name   string
+	closed bool
+}
+
+// Close implements the io.Closer interface
+func (r *snapshotResource) Close() error {
+	r.closed = true
+	return nil
+}
+
+// snapshotConsumer depends on the snapshotResource
+type snapshotConsumer struct {
+	res *snapshotResource
+}
+
+// snapshotGetterConsumer resolves the snapshotResource from the ServiceGetter
+type snapshotGetterConsumer struct {
+	sg ServiceGetter
+}
+
+// res resolves the snapshotResource
+func (c *snapshotGetterConsumer) res() *snapshotResource {
+	return MustGetService[*snapshotResource](c.sg)
+}
+
+// newSnapshotContainer creates the Container used in the Clone and Snapshot tests
+func newSnapshotContainer() *Container {
+	return NewContainer(
+		WithFactory(func() *snapshotResource {
+			return &snapshotResource{name: "real"}
+		}),
+		WithFactory(func(res *snapshotResource) *snapshotConsumer {
+			return &snapshotConsumer{res: res}
+		}),
+	)
+}
+
+// CloneSuite is the suite for testing the Container.Clone method
+type CloneSuite struct {
+	suite.Suite
+	c *Container
+}
+
+// SetupTest creates the Container
+func (suite *CloneSuite) SetupTest() {
+	suite.c = newSnapshotContainer()
+}
+
+// TestSharesCreated tests that the clone shares the instances created before
+func (suite *CloneSuite) TestSharesCreated() {
+	// Arrange
+	res := MustGetService[*snapshotResource](suite.c)
+
+	// Act
+	clone := suite.c.Clone()
+
+	// Assert
+	suite.Same(res, MustGetService[*snapshotResource](clone))
+	suite.Same(res, MustGetService[*snapshotConsumer](clone).res)
+	suite.NotSame(MustGetService[*snapshotConsumer](suite.c), MustGetService[*snapshotConsumer](clone))
+}
+
+// TestOverride tests that the clone options do not change the Container
+func (suite *CloneSuite) TestOverride() {
+	// Arrange
+	fake := &snapshotResource{name: "fake"}
+
+	// Act
+	clone := suite.c.Clone(WithOverride(fake))
+
+	// Assert
+	suite.Same(fake, MustGetService[*snapshotConsumer](clone).res)
+	suite.Equal("real", MustGetService[*snapshotConsumer](suite.c).res.name)
+}
+
+// TestOverrideCreated tests that the dependents created before the Clone
+// are created again with the overridden services
+func (suite *CloneSuite) TestOverrideCreated() {
+	// Arrange
+	fake := &snapshotResource{name: "fake"}
+	consumer := MustGetService[*snapshotConsumer](suite.c)
+
+	// Act
+	clone := suite.c.Clone(WithOverride(fake))
+
+	// Assert
+	cloned := MustGetService[*snapshotConsumer](clone)
+	suite.NotSame(consumer, cloned)
+	suite.Same(fake, cloned.res)
+	suite.Same(consumer, MustGetService[*snapshotConsumer](suite.c))
+	suite.Equal("real", consumer.res.name)
+}
+
+// TestOverrideGetter tests that the services depending on the ServiceGetter
+// created before the Clone resolve the overridden services from the clone
+func (suite *CloneSuite) TestOverrideGetter() {
+	// Arrange
+	c := NewContainer(
+		WithFactory(func() *snapshotResource {
+			return &snapshotResource{name: "real"}
+		}),
+		WithFactory(func(sg ServiceGetter) *snapshotGetterConsumer {
+			return &snapshotGetterConsumer{sg: sg}
+		}),
+	)
+	consumer := MustGetService[*snapshotGetterConsumer](c)
+
+	// Act
+	clone := c.Clone(WithOverride(&snapshotResource{name: "fake"}))
+
+	// Assert
+	suite.Equal("fake", MustGetService[*snapshotGetterConsumer](clone).res().name)
+	suite.Equal("real", consumer.res().name)
+}
+
+// TestBuiltin tests that the clone resolves itself
+func (suite *CloneSuite) TestBuiltin() {
+	// Act
+	clone := suite.c.Clone()
+
+	// Assert
+	suite.Same(clone, MustGetService[*Container](clone))
+	suite.Len(MustGetService[[]*Container](clone), 1)
+}
+
+// TestClose tests that the clone closes only the services it created
+func (suite *CloneSuite) TestClose() {
+	// Arrange
+	shared := MustGetService[*snapshotResource](suite.c)
+	clone := suite.c.Clone(WithFactory(func() *snapshotResource {
+		return &snapshotResource{name: "clone"}
+	}))
+	own := MustGetService[*snapshotResource](clone)
+
+	// Act
+	err := clone.Close()
+
+	// Assert
+	suite.NoError(err)
+	suite.True(own.closed)
+	suite.False(shared.closed)
+}
+
+// TestClone runs the CloneSuite
+func TestClone(t *testing.T) {
+	suite.Run(t, new(CloneSuite))
+}
+
+// SnapshotSuite is the suite for testing the Container.Snapshot and Container.Restore methods
+type SnapshotSuite struct {
+	suite.Suite
+	c *Container
+}
+
+// SetupTest creates the Container
+func (suite *SnapshotSuite) SetupTest() {
+	suite.c = newSnapshotContainer()
+}
+
+// TestRestore tests that the services created after the Snapshot are created again
+func (suite *SnapshotSuite) TestRestore() {
+	// Arrange
+	s := suite.c.Snapshot()
+	consumer := MustGetService[*snapshotConsumer](suite.c)
+
+	// Act
+	err := suite.c.Restore(s)
+
+	// Assert
+	suite.NoError(err)
+	suite.True(consumer.res.closed)
+
+	restored := MustGetService[*snapshotConsumer](suite.c)
+	suite.NotSame(consumer, restored)
+	suite.False(restored.res.closed)
+}
+
+// TestNotTouched tests that the services created before the Snapshot are not closed nor created again
+func (suite *SnapshotSuite) TestNotTouched() {
+	// Arrange
+	res := MustGetService[*snapshotResource](suite.c)
+	s := suite.c.Snapshot()
+	consumer := MustGetService[*snapshotConsumer](suite.c)
+
+	// Act
+	err := suite.c.Restore(s)
+
+	// Assert
+	suite.NoError(err)
+	suite.False(res.closed)
+	suite.Same(res, MustGetService[*snapshotResource](suite.c))
+	suite.NotSame(consumer, MustGetService[*snapshotConsumer](suite.c))
+
+	suite.NoError(suite.c.Close())
+	suite.True(res.closed)
+}
+
+// TestForeign tests that the Snapshot of another Container is not restored
+func (suite *SnapshotSuite) TestForeign() {
+	// Arrange
+	s := newSnapshotContainer().Snapshot()
+
+	// Act
+	err := suite.c.Restore(s)
+
+	// Assert
+	suite.ErrorIs(err, ErrForeignSnapshot)
+}
+
+// TestSnapshot runs the SnapshotSuite
+func TestSnapshot(t *testing.T) {
+	suite.Run(t, new(SnapshotSuite))
+}
@@ -0,0 +1,120 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Validator is an interface for the services validated when the Container is built
+type Validator interface {
+	// Validate returns an error if the service is invalid
+	Validate() error
+}
+
+// ValidationError is the error reporting all the services failed the validation
+type ValidationError struct {
+	// Errs are the validation errors of every invalid service
+	Errs []error
+}
+
+// Error implements the error interface for ValidationError.
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	b.WriteString("di: services validation failed:")
+	for _, err := range e.Errs {
+		b.WriteString("\n\t")
+		b.WriteString(strings.ReplaceAll(err.Error(), "\n", "\n\t"))
+	}
+
+	return b.String()
+}
+
+// Unwrap returns the validation errors
+func (e *ValidationError) Unwrap() []error {
+	return e.Errs
+}
+
+// validateOnBuildOption marks the service validated when the Container is built
+type validateOnBuildOption struct{}
+
+// applyService applies the ServiceOption
+func (opt *validateOnBuildOption) applyService(accessor *serviceAccessor) {
+	accessor.validateOnBuild = true
+}
+
+// ValidateOnBuild marks the service created and validated when the Container is built.
+//
+// The service creation error and the Validator implementation error
+// are reported by the NewContainer function.
+// The Scoped and Transient services are created in a new scope closed after the validation,
+// so they must not depend on the services added by the scope options, e.g. the HTTP request
+func ValidateOnBuild() ServiceOption {
+	return &validateOnBuildOption{}
+}
+
+// validate creates and validates the services marked with the ValidateOnBuild option.
+//
+// Returns the ValidationError listing every invalid service or nil
+func (c *Container) validate() error {
+	var scope *Container
+	var errs []error
+	for _, accessor := range c.registrations {
+		if !accessor.validateOnBuild {
+			continue
+		}
+
+		if scope == nil && accessor.factory != nil && accessor.lifetime != Singleton {
+			scope = c.NewScope()
+		}
+
+		instance, err := validationInstance(context.Background(), scope, accessor)
+		if err == nil {
+			if v, ok := instance.Interface().(Validator); ok {
+				err = v.Validate()
+			}
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("[%v]: %w", accessor.id, err))
+		}
+	}
+
+	if scope != nil {
+		if err := scope.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("di: failed to close the validation scope: %w", err))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &ValidationError{
+		Errs: errs,
+	}
+}
+
+// validationInstance creates the service instance to validate.
+// The Scoped and Transient services are created in the provided scope
+func validationInstance(ctx context.Context, scope *Container, accessor *serviceAccessor) (reflect.Value, error) {
+	if accessor.factory == nil || accessor.lifetime == Singleton {
+		return accessor.Instance(ctx)
+	}
+
+	if accessor.lifetime == Transient {
+		return accessor.cloneTo(scope).Instance(ctx)
+	}
+
+	scoped, err := scope.scopedAccessor(accessor)
+	if err != nil {
+		return reflect.Zero(accessor.id.Type), err
+	}
+
+	return scoped.Instance(ctx)
+}
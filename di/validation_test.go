@@ -0,0 +1,133 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package di
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+// portConfig is the Validator implementation used in the tests
+type portConfig struct {
+	Port int
+}
+
+// Validate implements the Validator interface
+func (cfg portConfig) Validate() error {
+	if cfg.Port <= 0 {
+		return errors.New("port must be positive")
+	}
+
+	return nil
+}
+
+// scopedPort is the closable Validator implementation used in the tests
+type scopedPort struct {
+	port   int
+	closed bool
+}
+
+// Validate implements the Validator interface
+func (p *scopedPort) Validate() error {
+	return portConfig{Port: p.port}.Validate()
+}
+
+// Close implements the io.Closer interface
+func (p *scopedPort) Close() error {
+	p.closed = true
+	return nil
+}
+
+// ValidationSuite is the suite for testing the services validation
+type ValidationSuite struct {
+	suite.Suite
+}
+
+// TestValid tests the valid services pass the validation
+func (suite *ValidationSuite) TestValid() {
+	// Arrange
+	c := &Container{accessors: make(serviceAccessors)}
+	WithValue(NewOptions(portConfig{Port: 80}), ValidateOnBuild()).apply(c)
+
+	// Act
+	err := c.validate()
+
+	// Assert
+	suite.NoError(err)
+}
+
+// TestAllViolations tests every invalid service is reported
+func (suite *ValidationSuite) TestAllViolations() {
+	// Arrange
+	c := &Container{accessors: make(serviceAccessors)}
+	WithValue(NewOptions(portConfig{}), ValidateOnBuild()).apply(c)
+	WithKeyedFactory("key", func() (string, error) {
+		return "", errors.ErrUnsupported
+	}, ValidateOnBuild()).apply(c)
+	WithValue(portConfig{}).apply(c)
+
+	// Act
+	err := c.validate()
+
+	// Assert
+	var validationErr *ValidationError
+	if suite.ErrorAs(err, &validationErr) {
+		suite.Len(validationErr.Errs, 2)
+	}
+	suite.ErrorIs(err, errors.ErrUnsupported)
+	suite.ErrorContains(err, "port must be positive")
+	suite.ErrorContains(err, "[string:key]")
+}
+
+// TestScoped tests the Scoped services are validated in the scope closed after the validation
+func (suite *ValidationSuite) TestScoped() {
+	// Arrange
+	var created []*scopedPort
+	b := NewBuilder(WithFactory(func() *scopedPort {
+		port := &scopedPort{port: 80}
+		created = append(created, port)
+		return port
+	}, WithLifetime(Scoped), ValidateOnBuild()))
+
+	// Act
+	c, err := b.Build()
+
+	// Assert
+	suite.Require().NoError(err)
+	if suite.Len(created, 1) {
+		suite.True(created[0].closed)
+	}
+
+	_, err = GetService[*scopedPort](c)
+	suite.ErrorIs(err, ErrScopeRequired)
+}
+
+// TestTransientInvalid tests the invalid Transient services are reported
+func (suite *ValidationSuite) TestTransientInvalid() {
+	// Arrange
+	b := NewBuilder(WithFactory(func() *scopedPort {
+		return &scopedPort{}
+	}, WithLifetime(Transient), ValidateOnBuild()))
+
+	// Act
+	_, err := b.Build()
+
+	// Assert
+	suite.ErrorContains(err, "port must be positive")
+}
+
+// TestNewContainer tests the NewContainer function panics for the invalid services
+func (suite *ValidationSuite) TestNewContainer() {
+	// Act & Assert
+	suite.Panics(func() {
+		NewContainer(WithValue(NewOptions(portConfig{}), ValidateOnBuild()))
+	})
+}
+
+// TestValidation tests the services validation
+func TestValidation(t *testing.T) {
+	suite.Run(t, new(ValidationSuite))
+}
@@ -0,0 +1,56 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package dihttp
+
+import (
+	"github.com/akimsavvin/gonet/v2/di"
+	"net/http"
+)
+
+// Middleware returns the middleware creating a new scope of the Container for every request.
+//
+// The *http.Request and the http.ResponseWriter are resolvable in the scope,
+// the scope is attached to the request context with the di.ContextWith function
+// and closed when the request is handled
+func Middleware(c *di.Container) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req *http.Request
+			scope := c.NewScope(
+				di.WithValue(w),
+				di.WithFactory(func() *http.Request {
+					return req
+				}),
+			)
+			defer func() {
+				_ = scope.Close()
+			}()
+
+			req = r.WithContext(di.ContextWith(r.Context(), scope))
+			next.ServeHTTP(w, req)
+		})
+	}
+}
+
+// Scope returns the request scope created by the Middleware
+func Scope(r *http.Request) (di.ServiceGetter, bool) {
+	return di.FromContext(r.Context())
+}
+
+// GetService returns the asserted service instance for the provided type
+// from the request scope created by the Middleware.
+//
+// Returns di.ErrNoServiceGetter if the request has no scope
+func GetService[T any](r *http.Request) (T, error) {
+	return di.GetServiceFromContext[T](r.Context())
+}
+
+// GetKeyedService returns the asserted service instance for the provided type and key
+// from the request scope created by the Middleware.
+//
+// Returns di.ErrNoServiceGetter if the request has no scope
+func GetKeyedService[T any](r *http.Request, key string) (T, error) {
+	return di.GetKeyedServiceFromContext[T](r.Context(), key)
+}
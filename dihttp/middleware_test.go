@@ -0,0 +1,105 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package dihttp
+
+import (
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// requestInfo is the Scoped service depending on the request used in the tests
+type requestInfo struct {
+	path   string
+	w      http.ResponseWriter
+	closed bool
+}
+
+// Close implements the io.Closer interface
+func (info *requestInfo) Close() error {
+	info.closed = true
+	return nil
+}
+
+// MiddlewareSuite is the suite for testing the Middleware
+type MiddlewareSuite struct {
+	suite.Suite
+}
+
+// newContainer creates a new Container with the requestInfo service
+func (suite *MiddlewareSuite) newContainer() *di.Container {
+	return di.NewContainer(di.WithFactory(func(r *http.Request, w http.ResponseWriter) *requestInfo {
+		return &requestInfo{
+			path: r.URL.Path,
+			w:    w,
+		}
+	}, di.WithLifetime(di.Scoped)))
+}
+
+// TestRequestScope tests the request services are resolved from the request scope
+// and closed when the request is handled
+func (suite *MiddlewareSuite) TestRequestScope() {
+	// Arrange
+	var infos []*requestInfo
+	h := Middleware(suite.newContainer())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info1, err1 := GetService[*requestInfo](r)
+		info2, err2 := GetService[*requestInfo](r)
+		suite.NoError(err1)
+		suite.NoError(err2)
+		suite.Same(info1, info2)
+		suite.Equal(w, info1.w)
+		suite.False(info1.closed)
+
+		infos = append(infos, info1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	// Act
+	rec1, rec2 := httptest.NewRecorder(), httptest.NewRecorder()
+	h.ServeHTTP(rec1, httptest.NewRequest(http.MethodGet, "/first", nil))
+	h.ServeHTTP(rec2, httptest.NewRequest(http.MethodGet, "/second", nil))
+
+	// Assert
+	suite.Equal(http.StatusNoContent, rec1.Code)
+	suite.Equal(http.StatusNoContent, rec2.Code)
+	if suite.Len(infos, 2) {
+		suite.Equal("/first", infos[0].path)
+		suite.Equal("/second", infos[1].path)
+		suite.True(infos[0].closed)
+		suite.True(infos[1].closed)
+	}
+}
+
+// TestRequestContext tests the resolved request carries the request scope
+func (suite *MiddlewareSuite) TestRequestContext() {
+	// Arrange
+	h := Middleware(suite.newContainer())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := GetService[*http.Request](r)
+		suite.NoError(err)
+
+		scope, ok := Scope(req)
+		suite.True(ok)
+		suite.NotNil(scope)
+	}))
+
+	// Act & Assert
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
+
+// TestNoScope tests the error is returned for the request without the scope
+func (suite *MiddlewareSuite) TestNoScope() {
+	// Act
+	_, err := GetService[*requestInfo](httptest.NewRequest(http.MethodGet, "/", nil))
+
+	// Assert
+	suite.ErrorIs(err, di.ErrNoServiceGetter)
+}
+
+// TestMiddleware tests the Middleware
+func TestMiddleware(t *testing.T) {
+	suite.Run(t, new(MiddlewareSuite))
+}
@@ -0,0 +1,82 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+// Package ditest provides the di containers for the tests
+package ditest
+
+import (
+	"github.com/akimsavvin/gonet/v2/di"
+	"strings"
+	"testing"
+)
+
+// Container is the di.Container built for a single test.
+//
+// The Container is a di.ServiceGetter, so the services are resolved with the di.GetService functions
+type Container struct {
+	*di.Container
+
+	// t is the test the Container is built for
+	t testing.TB
+
+	// built are the built di.Container and its clones with the overrides in the creation order
+	built []*di.Container
+}
+
+// New builds a new Container with the provided options for the test.
+//
+// The Container is closed when the test and all its subtests complete.
+// Fails the test if the Container can not be built
+func New(t testing.TB, opts ...di.Option) *Container {
+	t.Helper()
+
+	built, err := di.NewBuilder(opts...).Build()
+	if err != nil {
+		t.Fatalf("ditest: failed to build the container: %v", err)
+	}
+
+	c := &Container{
+		Container: built,
+		t:         t,
+		built:     []*di.Container{built},
+	}
+
+	t.Cleanup(c.close)
+
+	return c
+}
+
+// close closes the built containers in the reverse creation order
+func (c *Container) close() {
+	for i := len(c.built) - 1; i >= 0; i-- {
+		if err := c.built[i].Close(); err != nil {
+			c.t.Errorf("ditest: failed to close the container: %v", err)
+		}
+	}
+}
+
+// Override replaces all the services of type T added to the Container with the fake.
+//
+// The Container is replaced with the di.Container clone with the override,
+// the services depending on T resolved before are created again by the clone.
+// The previous di.Container is not changed and is closed when the test completes,
+// so the di.Container passed on before the Override keeps resolving the replaced services
+func Override[T any](c *Container, fake T, svcOpts ...di.ServiceOption) {
+	c.t.Helper()
+
+	c.Container = c.Clone(di.WithOverride(fake, svcOpts...))
+	c.built = append(c.built, c.Container)
+}
+
+// FailUnresolved fails the test if any of the services added to the Container
+// was not resolved by the end of the test.
+//
+// Call it after the overrides, the services replaced by Override are not reported
+func (c *Container) FailUnresolved() {
+	c.t.Cleanup(func() {
+		if unresolved := c.Unresolved(); len(unresolved) > 0 {
+			c.t.Errorf("ditest: services were never resolved: %s", strings.Join(unresolved, ", "))
+		}
+	})
+}
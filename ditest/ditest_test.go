@@ -0,0 +1,237 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package ditest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"runtime"
+	"testing"
+)
+
+// fakeT is the testing.TB recording the failures and the cleanups
+type fakeT struct {
+	testing.TB
+	errs     []string
+	fatal    bool
+	cleanups []func()
+}
+
+// Helper implements the testing.TB interface
+func (t *fakeT) Helper() {}
+
+// Errorf implements the testing.TB interface
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errs = append(t.errs, fmt.Sprintf(format, args...))
+}
+
+// Fatalf implements the testing.TB interface
+func (t *fakeT) Fatalf(format string, args ...any) {
+	t.Errorf(format, args...)
+	t.fatal = true
+	runtime.Goexit()
+}
+
+// Cleanup implements the testing.TB interface
+func (t *fakeT) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+// finish runs the cleanups in the reverse order
+func (t *fakeT) finish() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+// run runs the function in a new goroutine like the testing package runs the tests
+func (t *fakeT) run(fn func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	<-done
+}
+
+// Repo is the dependency replaced in the tests
+type Repo interface {
+	Name() string
+}
+
+// repo implements the Repo interface
+type repo struct {
+	name string
+}
+
+// Name implements the Repo interface
+func (r *repo) Name() string {
+	return r.name
+}
+
+// closer records the Close calls
+type closer struct {
+	closed bool
+}
+
+// Close implements the io.Closer interface
+func (c *closer) Close() error {
+	c.closed = true
+	return nil
+}
+
+// Config is the struct dependency filled with the fakes
+type Config struct {
+	Host string
+	Port int
+}
+
+// Service depends on the Repo and the Config
+type Service struct {
+	Repo   Repo
+	Config *Config
+}
+
+// NewService creates a new Service
+func NewService(repo Repo, cfg *Config) *Service {
+	return &Service{
+		Repo:   repo,
+		Config: cfg,
+	}
+}
+
+// ContainerSuite is the suite for testing the Container
+type ContainerSuite struct {
+	suite.Suite
+}
+
+// TestNew tests that the Container resolves the services and is closed on the test cleanup
+func (suite *ContainerSuite) TestNew() {
+	// Arrange
+	t := &fakeT{}
+	cl := &closer{}
+
+	// Act
+	c := New(t, di.WithFactory(func() *closer { return cl }))
+
+	// Assert
+	suite.Same(cl, di.MustGetService[*closer](c))
+	suite.False(cl.closed)
+
+	t.finish()
+	suite.True(cl.closed)
+	suite.Empty(t.errs)
+}
+
+// TestBuildError tests that the test fails if the Container can not be built
+func (suite *ContainerSuite) TestBuildError() {
+	// Arrange
+	t := &fakeT{}
+
+	// Act
+	t.run(func() {
+		New(t, di.WithFactory(func() (*repo, error) {
+			return nil, errors.New("failed")
+		}, di.ValidateOnBuild()))
+	})
+
+	// Assert
+	suite.True(t.fatal)
+	suite.Len(t.errs, 1)
+}
+
+// TestOverride tests that the Override replaces the service for the dependent services
+func (suite *ContainerSuite) TestOverride() {
+	// Arrange
+	t := &fakeT{}
+	c := New(t,
+		di.WithService[Repo](func() Repo { return &repo{name: "real"} }),
+		di.WithValue(&Config{}),
+		di.WithFactory(NewService),
+	)
+
+	// Act
+	Override[Repo](c, &repo{name: "fake"})
+
+	// Assert
+	svc := di.MustGetService[*Service](c)
+	suite.Equal("fake", svc.Repo.Name())
+	suite.Len(di.MustGetService[[]Repo](c), 1)
+}
+
+// TestOverrideKeepsPrevious tests that the Override does not close the previous container
+// and replaces the services resolved before
+func (suite *ContainerSuite) TestOverrideKeepsPrevious() {
+	// Arrange
+	t := &fakeT{}
+	cl := &closer{}
+	c := New(t,
+		di.WithService[Repo](func() Repo { return &repo{name: "real"} }),
+		di.WithValue(&Config{}),
+		di.WithFactory(NewService),
+		di.WithFactory(func() *closer { return cl }),
+	)
+	previous := c.Container
+	di.MustGetService[*closer](c)
+	di.MustGetService[*Service](c)
+
+	// Act
+	Override[Repo](c, &repo{name: "fake"})
+
+	// Assert
+	suite.False(cl.closed)
+	suite.Equal("fake", di.MustGetService[*Service](c).Repo.Name())
+	suite.Equal("real", di.MustGetService[*Service](previous).Repo.Name())
+
+	t.finish()
+	suite.True(cl.closed)
+	suite.Empty(t.errs)
+}
+
+// TestFailUnresolved tests that the test fails if any of the services was not resolved
+func (suite *ContainerSuite) TestFailUnresolved() {
+	// Arrange
+	t := &fakeT{}
+	c := New(t,
+		di.WithService[Repo](func() Repo { return &repo{} }),
+		di.WithValue(&Config{}),
+		di.WithFactory(NewService),
+		di.WithKeyedValue("unused", 1),
+	)
+
+	Override[Repo](c, &repo{name: "fake"})
+	c.FailUnresolved()
+
+	// Act
+	di.MustGetService[*Service](c)
+	t.finish()
+
+	// Assert
+	if suite.Len(t.errs, 1) {
+		suite.Equal("ditest: services were never resolved: int:unused", t.errs[0])
+	}
+}
+
+// TestAllResolved tests that the test does not fail if all the services were resolved
+func (suite *ContainerSuite) TestAllResolved() {
+	// Arrange
+	t := &fakeT{}
+	c := New(t, di.WithValue(&Config{}))
+	c.FailUnresolved()
+
+	// Act
+	di.MustGetService[*Config](c)
+	t.finish()
+
+	// Assert
+	suite.Empty(t.errs)
+}
+
+// TestContainer runs the ContainerSuite
+func TestContainer(t *testing.T) {
+	suite.Run(t, new(ContainerSuite))
+}
@@ -0,0 +1,71 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package ditest
+
+import (
+	"context"
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/brianvoe/gofakeit/v7"
+	"reflect"
+	"sync"
+)
+
+// fakeResolver is the di.Resolver filling the structs with the fake values
+type fakeResolver struct {
+	// mu protects the faker and the fakes
+	mu sync.Mutex
+
+	// faker generates the fake values
+	faker *gofakeit.Faker
+
+	// fakes are the generated fakes by the type,
+	// so every dependency of the same type receives the same fake
+	fakes map[reflect.Type]any
+}
+
+// WithFakes fills the struct and the pointer to struct services not added to the Container
+// with the values generated by gofakeit. The fake of the same type is generated once.
+//
+// The seed makes the fakes reproducible, zero seed generates random fakes
+func WithFakes(seed uint64) di.Option {
+	return di.WithFallback(&fakeResolver{
+		faker: gofakeit.New(seed),
+		fakes: make(map[reflect.Type]any),
+	})
+}
+
+// Resolve implements the di.Resolver interface
+func (r *fakeResolver) Resolve(_ context.Context, typ reflect.Type, _ string, keyed bool) (any, error) {
+	isPtr := typ.Kind() == reflect.Pointer
+	elem := typ
+	if isPtr {
+		elem = typ.Elem()
+	}
+
+	if keyed || elem.Kind() != reflect.Struct {
+		return nil, di.ErrServiceNotFound
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if fake, ok := r.fakes[typ]; ok {
+		return fake, nil
+	}
+
+	ptr := reflect.New(elem)
+	if err := r.faker.Struct(ptr.Interface()); err != nil {
+		return nil, fmt.Errorf("ditest: failed to generate the %v fake: %w", typ, err)
+	}
+
+	fake := ptr.Interface()
+	if !isPtr {
+		fake = ptr.Elem().Interface()
+	}
+
+	r.fakes[typ] = fake
+	return fake, nil
+}
@@ -0,0 +1,88 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package ditest
+
+import (
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+// FakesSuite is the suite for testing the WithFakes option
+type FakesSuite struct {
+	suite.Suite
+}
+
+// TestDependency tests that the missing struct dependency is filled with the fakes
+func (suite *FakesSuite) TestDependency() {
+	// Arrange
+	c := New(suite.T(),
+		WithFakes(1),
+		di.WithValue[Repo](&repo{}),
+		di.WithFactory(NewService),
+	)
+
+	// Act
+	svc, err := di.GetService[*Service](c)
+
+	// Assert
+	suite.Require().NoError(err)
+	suite.NotEmpty(svc.Config.Host)
+	suite.Same(svc.Config, di.MustGetService[*Config](c))
+}
+
+// TestValue tests that the struct values are filled with the fakes
+func (suite *FakesSuite) TestValue() {
+	// Arrange
+	c := New(suite.T(), WithFakes(1))
+
+	// Act
+	cfg, err := di.GetService[Config](c)
+
+	// Assert
+	suite.NoError(err)
+	suite.NotEmpty(cfg.Host)
+}
+
+// TestReproducible tests that the same seed generates the same fakes
+func (suite *FakesSuite) TestReproducible() {
+	// Arrange
+	c1 := New(suite.T(), WithFakes(42))
+	c2 := New(suite.T(), WithFakes(42))
+
+	// Act & Assert
+	suite.Equal(di.MustGetService[Config](c1), di.MustGetService[Config](c2))
+}
+
+// TestRegistered tests that the registered services are not replaced with the fakes
+func (suite *FakesSuite) TestRegistered() {
+	// Arrange
+	cfg := &Config{Host: "localhost"}
+	c := New(suite.T(), WithFakes(1), di.WithValue(cfg))
+
+	// Act & Assert
+	suite.Same(cfg, di.MustGetService[*Config](c))
+}
+
+// TestNotStruct tests that only the structs are filled with the fakes
+func (suite *FakesSuite) TestNotStruct() {
+	// Arrange
+	c := New(suite.T(), WithFakes(1))
+
+	// Act
+	_, repoErr := di.GetService[Repo](c)
+	_, strErr := di.GetService[string](c)
+	_, keyedErr := di.GetKeyedService[*Config](c, "key")
+
+	// Assert
+	suite.ErrorIs(repoErr, di.ErrServiceNotFound)
+	suite.ErrorIs(strErr, di.ErrServiceNotFound)
+	suite.ErrorIs(keyedErr, di.ErrServiceNotFound)
+}
+
+// TestFakes runs the FakesSuite
+func TestFakes(t *testing.T) {
+	suite.Run(t, new(FakesSuite))
+}
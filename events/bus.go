@@ -0,0 +1,348 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"hash/fnv"
+	"log"
+	"reflect"
+	"sync"
+)
+
+var (
+	// ErrBusClosed is the error returned when the event is published to the closed Bus
+	ErrBusClosed = errors.New("events: bus is closed")
+)
+
+// ErrorHandler handles the errors of the events dispatched asynchronously
+type ErrorHandler func(ctx context.Context, event any, err error)
+
+// envelope is the published event with its handlers dispatch
+type envelope struct {
+	// typ is the event type
+	typ reflect.Type
+
+	// event is the published event
+	event any
+
+	// dispatch dispatches the event to its handlers
+	dispatch func(ctx context.Context) error
+}
+
+// newEnvelope creates a new envelope for the event and its resolved handlers
+func newEnvelope[E any](event E, handlers []Handler[E]) envelope {
+	return envelope{
+		typ:   reflect.TypeFor[E](),
+		event: event,
+		dispatch: func(ctx context.Context) error {
+			return dispatch(ctx, event, handlers)
+		},
+	}
+}
+
+// newScopedEnvelope creates a new envelope for the event
+// resolving its handlers from the ServiceGetter attached to the dispatch context
+func newScopedEnvelope[E any](event E) envelope {
+	return envelope{
+		typ:   reflect.TypeFor[E](),
+		event: event,
+		dispatch: func(ctx context.Context) error {
+			sg, ok := di.FromContext(ctx)
+			if !ok {
+				return di.ErrNoServiceGetter
+			}
+
+			handlers, err := resolveHandlers[E](ctx, sg)
+			if err != nil {
+				return err
+			}
+
+			return dispatch(ctx, event, handlers)
+		},
+	}
+}
+
+// task is the envelope queued for the asynchronous dispatch
+type task struct {
+	ctx context.Context
+	env envelope
+}
+
+// workerKey is the context key of the Bus dispatching the task
+type workerKey struct{}
+
+// BusOption is an interface configuring the Bus
+type BusOption interface {
+	applyBus(*Bus)
+}
+
+// asyncOption makes the Bus dispatch the events asynchronously
+type asyncOption struct {
+	workers   int
+	queueSize int
+}
+
+// applyBus applies the BusOption
+func (opt *asyncOption) applyBus(bus *Bus) {
+	if opt.workers < 1 {
+		log.Panicf("[%d]: bus workers count must be positive\n", opt.workers)
+	}
+
+	if opt.queueSize < 0 {
+		log.Panicf("[%d]: bus queue size must not be negative\n", opt.queueSize)
+	}
+
+	bus.workers = opt.workers
+	bus.queueSize = opt.queueSize
+}
+
+// Async makes the Bus dispatch the events asynchronously with the bounded worker pool.
+//
+// The events of the same type are always dispatched by the same worker
+// and are handled in the publishing order.
+// Every worker queues up to queueSize events,
+// the publishing blocks until the queue has space, the context is done or the Bus is closed.
+// The events published by the handlers dispatched by the Bus are dispatched inline,
+// so the handlers never block on the full queue.
+//
+// The Bus added with the WithBus option resolves the handlers of every event
+// in a new scope of the Container closed after the event is dispatched,
+// so the Scoped handlers outlive the publishing scope, e.g. the HTTP request.
+// The Bus created with the NewBus function resolves the handlers when the event is published
+// and must not dispatch the events to the Scoped handlers
+func Async(workers, queueSize int) BusOption {
+	return &asyncOption{
+		workers:   workers,
+		queueSize: queueSize,
+	}
+}
+
+// errorHandlerOption sets the Bus ErrorHandler
+type errorHandlerOption struct {
+	handler ErrorHandler
+}
+
+// applyBus applies the BusOption
+func (opt *errorHandlerOption) applyBus(bus *Bus) {
+	bus.onError = opt.handler
+}
+
+// WithErrorHandler sets the ErrorHandler called with the errors
+// of the events dispatched asynchronously.
+//
+// The errors are logged by default
+func WithErrorHandler(handler ErrorHandler) BusOption {
+	return &errorHandlerOption{
+		handler: handler,
+	}
+}
+
+// Bus dispatches the published events to their handlers.
+//
+// The Bus dispatches the events synchronously by default:
+// the Publish function returns after all the handlers are called and returns their errors.
+// Use the Async option to dispatch the events with the worker pool
+type Bus struct {
+	// workers is the number of the async workers, 0 for the sync Bus
+	workers int
+
+	// queueSize is the capacity of every worker queue
+	queueSize int
+
+	// onError handles the errors of the async dispatch
+	onError ErrorHandler
+
+	// cont is the Container the Bus was added to with the WithBus option,
+	// nil for the Bus created with the NewBus function
+	cont *di.Container
+
+	// mu guards the closed flag and the senders registration
+	mu sync.Mutex
+
+	// closed is true after the Bus is closed
+	closed bool
+
+	// done is closed when the Bus is closed, unblocking the senders
+	done chan struct{}
+
+	// senders waits for the publishing sending to the queues
+	senders sync.WaitGroup
+
+	// queues are the workers queues
+	queues []chan task
+
+	// wg waits for the workers to drain their queues
+	wg sync.WaitGroup
+}
+
+// NewBus creates a new Bus and starts its workers
+func NewBus(opts ...BusOption) *Bus {
+	bus := &Bus{
+		onError: logError,
+		done:    make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt.applyBus(bus)
+	}
+
+	bus.queues = make([]chan task, bus.workers)
+	for i := range bus.queues {
+		bus.queues[i] = make(chan task, bus.queueSize)
+
+		bus.wg.Add(1)
+		go bus.work(bus.queues[i])
+	}
+
+	return bus
+}
+
+// WithBus adds the Bus created with the provided options to the Container.
+// The async Bus resolves the event handlers in a new scope of the Container for every event.
+//
+// The started Container drains the Bus when it is stopped,
+// before the handlers dependencies are closed with the Container, e.g. by the host.Host.
+// The Container closes the Bus when it is closed
+func WithBus(opts ...BusOption) di.Option {
+	return di.WithFactory(func(c *di.Container) *Bus {
+		bus := NewBus(opts...)
+		bus.cont = c
+		return bus
+	})
+}
+
+// Stop implements the di.Stopper interface.
+//
+// Stops accepting the events and waits for the queued events to be dispatched like the Close method
+// or for the context to be done
+func (bus *Bus) Stop(ctx context.Context) error {
+	drained := make(chan struct{})
+	go func() {
+		_ = bus.Close()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting the events and waits for the queued events to be dispatched.
+// The publishing blocked on the full queue returns ErrBusClosed
+func (bus *Bus) Close() error {
+	bus.mu.Lock()
+	if bus.closed {
+		bus.mu.Unlock()
+		bus.wg.Wait()
+		return nil
+	}
+
+	bus.closed = true
+	close(bus.done)
+	bus.mu.Unlock()
+
+	bus.senders.Wait()
+	for _, queue := range bus.queues {
+		close(queue)
+	}
+
+	bus.wg.Wait()
+	return nil
+}
+
+// isAsync reports whether the Bus dispatches the events asynchronously
+func (bus *Bus) isAsync() bool {
+	return bus.workers > 0
+}
+
+// publish dispatches the envelope synchronously or queues it to the worker
+// dispatching the envelope event type.
+//
+// The envelope published by the Bus worker is dispatched inline.
+// The queued envelope is dispatched with the context detached from the publishing context cancellation
+func (bus *Bus) publish(ctx context.Context, env envelope) error {
+	if worker, _ := ctx.Value(workerKey{}).(*Bus); worker == bus {
+		return env.dispatch(ctx)
+	}
+
+	if !bus.isAsync() {
+		if bus.isClosed() {
+			return ErrBusClosed
+		}
+
+		return env.dispatch(ctx)
+	}
+
+	bus.mu.Lock()
+	if bus.closed {
+		bus.mu.Unlock()
+		return ErrBusClosed
+	}
+	bus.senders.Add(1)
+	bus.mu.Unlock()
+	defer bus.senders.Done()
+
+	select {
+	case bus.queues[bus.shard(env.typ)] <- task{ctx: context.WithoutCancel(ctx), env: env}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-bus.done:
+		return ErrBusClosed
+	}
+}
+
+// isClosed reports whether the Bus is closed
+func (bus *Bus) isClosed() bool {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	return bus.closed
+}
+
+// shard returns the index of the worker dispatching the events of the type
+func (bus *Bus) shard(typ reflect.Type) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(typ.String()))
+	return int(h.Sum32() % uint32(bus.workers))
+}
+
+// work dispatches the queued tasks until the queue is closed
+func (bus *Bus) work(queue <-chan task) {
+	defer bus.wg.Done()
+
+	for t := range queue {
+		bus.dispatch(context.WithValue(t.ctx, workerKey{}, bus), t.env)
+	}
+}
+
+// dispatch dispatches the queued envelope in a new scope of the Bus Container
+// and passes the errors to the ErrorHandler
+func (bus *Bus) dispatch(ctx context.Context, env envelope) {
+	if bus.cont != nil {
+		scope := bus.cont.NewScope()
+		ctx = di.ContextWith(ctx, scope)
+		defer func() {
+			if err := scope.Close(); err != nil {
+				bus.onError(ctx, env.event, err)
+			}
+		}()
+	}
+
+	if err := env.dispatch(ctx); err != nil {
+		bus.onError(ctx, env.event, err)
+	}
+}
+
+// logError is the default ErrorHandler logging the error
+func logError(_ context.Context, event any, err error) {
+	log.Printf("events: failed to handle %T: %v\n", event, err)
+}
@@ -0,0 +1,341 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/akimsavvin/gonet/v2/dihttp"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// orderPlaced is the second event type used in the tests
+type orderPlaced struct {
+	id int
+}
+
+// requestLog is the Scoped dependency of the handlers used in the tests
+type requestLog struct {
+	closed bool
+}
+
+// Close implements the io.Closer interface
+func (l *requestLog) Close() error {
+	l.closed = true
+	return nil
+}
+
+// BusSuite is the suite for testing the Bus
+type BusSuite struct {
+	suite.Suite
+}
+
+// TestSync tests that the sync Bus returns the handlers errors
+func (suite *BusSuite) TestSync() {
+	// Arrange
+	errFailed := errors.New("failed")
+	c := di.NewContainer(
+		WithBus(),
+		di.WithService[Handler[userCreated]](&recorder{err: errFailed}),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+
+	// Act
+	err := Publish(ctx, userCreated{id: 1})
+
+	// Assert
+	suite.ErrorIs(err, errFailed)
+}
+
+// TestAsyncOrdering tests that the async Bus handles the events of the same type
+// in the publishing order and drains the queues when closed
+func (suite *BusSuite) TestAsyncOrdering() {
+	// Arrange
+	var mu sync.Mutex
+	var users, orders []int
+
+	c := di.NewContainer(
+		WithBus(Async(4, 8)),
+		di.WithValue[Handler[userCreated]](HandlerFunc[userCreated](func(ctx context.Context, event userCreated) error {
+			mu.Lock()
+			defer mu.Unlock()
+			users = append(users, event.id)
+			return nil
+		})),
+		di.WithValue[Handler[orderPlaced]](HandlerFunc[orderPlaced](func(ctx context.Context, event orderPlaced) error {
+			mu.Lock()
+			defer mu.Unlock()
+			orders = append(orders, event.id)
+			return nil
+		})),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+
+	var expected []int
+	for i := range 100 {
+		expected = append(expected, i)
+
+		// Act
+		suite.Require().NoError(Publish(ctx, userCreated{id: i}))
+		suite.Require().NoError(Publish(ctx, orderPlaced{id: i}))
+	}
+
+	suite.Require().NoError(c.Close())
+
+	// Assert
+	suite.Equal(expected, users)
+	suite.Equal(expected, orders)
+}
+
+// TestAsyncErrorHandler tests that the async dispatch errors are passed to the ErrorHandler
+func (suite *BusSuite) TestAsyncErrorHandler() {
+	// Arrange
+	errFailed := errors.New("failed")
+
+	var handled []error
+	bus := NewBus(Async(1, 1), WithErrorHandler(func(ctx context.Context, event any, err error) {
+		handled = append(handled, err)
+	}))
+
+	c := di.NewContainer(
+		di.WithValue(bus),
+		di.WithService[Handler[userCreated]](&recorder{err: errFailed}),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+
+	// Act
+	err := Publish(ctx, userCreated{id: 1})
+	suite.Require().NoError(bus.Close())
+
+	// Assert
+	suite.NoError(err)
+	if suite.Len(handled, 1) {
+		suite.ErrorIs(handled[0], errFailed)
+	}
+}
+
+// TestClosed tests that the closed Bus rejects the events
+func (suite *BusSuite) TestClosed() {
+	// Arrange
+	bus := NewBus(Async(1, 0))
+	c := di.NewContainer(
+		di.WithValue(bus),
+		di.WithService[Handler[userCreated]](&recorder{}),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+	suite.Require().NoError(bus.Close())
+
+	// Act
+	err := Publish(ctx, userCreated{id: 1})
+
+	// Assert
+	suite.ErrorIs(err, ErrBusClosed)
+}
+
+// TestPublishCancelled tests that the publishing to the full queue stops when the context is done
+func (suite *BusSuite) TestPublishCancelled() {
+	// Arrange
+	release := make(chan struct{})
+	bus := NewBus(Async(1, 0))
+	defer bus.Close()
+	defer close(release)
+
+	c := di.NewContainer(
+		di.WithValue(bus),
+		di.WithValue[Handler[userCreated]](HandlerFunc[userCreated](func(ctx context.Context, event userCreated) error {
+			<-release
+			return nil
+		})),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+	suite.Require().NoError(Publish(ctx, userCreated{id: 1}))
+
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	// Act
+	err := Publish(ctx, userCreated{id: 2})
+
+	// Assert
+	suite.ErrorIs(err, context.DeadlineExceeded)
+}
+
+// TestRepublishFullQueue tests that the handler publishing to its own full queue
+// does not deadlock the Bus and the Bus is closed
+func (suite *BusSuite) TestRepublishFullQueue() {
+	// Arrange
+	var orders []int
+	c := di.NewContainer(
+		WithBus(Async(1, 0)),
+		di.WithValue[Handler[userCreated]](HandlerFunc[userCreated](func(ctx context.Context, event userCreated) error {
+			return Publish(ctx, orderPlaced{id: event.id})
+		})),
+		di.WithValue[Handler[orderPlaced]](HandlerFunc[orderPlaced](func(ctx context.Context, event orderPlaced) error {
+			orders = append(orders, event.id)
+			return nil
+		})),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+
+	// Act
+	for i := range 3 {
+		suite.Require().NoError(Publish(ctx, userCreated{id: i}))
+	}
+
+	closed := make(chan error, 1)
+	go func() {
+		closed <- c.Close()
+	}()
+
+	// Assert
+	select {
+	case err := <-closed:
+		suite.NoError(err)
+		suite.Equal([]int{0, 1, 2}, orders)
+	case <-time.After(time.Second):
+		suite.Fail("bus close deadlocked")
+	}
+}
+
+// TestCloseBlockedPublish tests that the publishing blocked on the full queue
+// returns ErrBusClosed when the Bus is closed
+func (suite *BusSuite) TestCloseBlockedPublish() {
+	// Arrange
+	release := make(chan struct{})
+	bus := NewBus(Async(1, 0))
+	c := di.NewContainer(
+		di.WithValue(bus),
+		di.WithValue[Handler[userCreated]](HandlerFunc[userCreated](func(ctx context.Context, event userCreated) error {
+			<-release
+			return nil
+		})),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+	suite.Require().NoError(Publish(ctx, userCreated{id: 1}))
+
+	published := make(chan error, 1)
+	go func() {
+		published <- Publish(ctx, userCreated{id: 2})
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+
+	// Act
+	closed := make(chan error, 1)
+	go func() {
+		closed <- bus.Close()
+	}()
+
+	// Assert
+	suite.ErrorIs(<-published, ErrBusClosed)
+
+	close(release)
+	suite.NoError(<-closed)
+}
+
+// TestAsyncScopedHandlers tests that the async Bus resolves the Scoped handlers
+// in the new scope outliving the HTTP request scope
+func (suite *BusSuite) TestAsyncScopedHandlers() {
+	// Arrange
+	errClosed := errors.New("request log is closed")
+	release := make(chan struct{})
+
+	var logs []*requestLog
+	var errs []error
+	c := di.NewContainer(
+		WithBus(Async(1, 1), WithErrorHandler(func(ctx context.Context, event any, err error) {
+			errs = append(errs, err)
+		})),
+		di.WithFactory(func() *requestLog {
+			return &requestLog{}
+		}, di.WithLifetime(di.Scoped)),
+		di.WithFactory(func(l *requestLog) Handler[userCreated] {
+			return HandlerFunc[userCreated](func(ctx context.Context, event userCreated) error {
+				<-release
+				if l.closed {
+					return errClosed
+				}
+
+				logs = append(logs, l)
+				return nil
+			})
+		}, di.WithLifetime(di.Scoped)),
+	)
+
+	h := dihttp.Middleware(c)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.NoError(Publish(r.Context(), userCreated{id: 1}))
+	}))
+
+	// Act
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/users", nil))
+	close(release)
+	suite.Require().NoError(c.Close())
+
+	// Assert
+	suite.Empty(errs)
+	if suite.Len(logs, 1) {
+		suite.True(logs[0].closed)
+	}
+}
+
+// TestStopDrains tests that the stopped Container drains the Bus
+// before the handlers dependencies are closed
+func (suite *BusSuite) TestStopDrains() {
+	// Arrange
+	release := make(chan struct{})
+
+	var handled []bool
+	c := di.NewContainer(
+		di.WithStartConcurrency(1),
+		WithBus(Async(1, 4)),
+		di.WithFactory(func() *requestLog {
+			return &requestLog{}
+		}),
+		di.WithFactory(func(l *requestLog) Handler[userCreated] {
+			return HandlerFunc[userCreated](func(ctx context.Context, event userCreated) error {
+				<-release
+				handled = append(handled, l.closed)
+				return nil
+			})
+		}),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+	suite.Require().NoError(c.Start(ctx))
+	for i := range 3 {
+		suite.Require().NoError(Publish(ctx, userCreated{id: i}))
+	}
+
+	time.AfterFunc(20*time.Millisecond, func() {
+		close(release)
+	})
+
+	// Act
+	stopErr := c.Stop(context.Background())
+	closeErr := c.Close()
+
+	// Assert
+	suite.NoError(stopErr)
+	suite.NoError(closeErr)
+	suite.Equal([]bool{false, false, false}, handled)
+}
+
+// TestBus runs the BusSuite
+func TestBus(t *testing.T) {
+	suite.Run(t, new(BusSuite))
+}
@@ -0,0 +1,101 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"reflect"
+)
+
+// Handler handles the events of the E type
+type Handler[E any] interface {
+	// Handle handles the event
+	Handle(ctx context.Context, event E) error
+}
+
+// HandlerFunc is the function implementing the Handler interface
+type HandlerFunc[E any] func(ctx context.Context, event E) error
+
+// Handle implements the Handler interface
+func (fn HandlerFunc[E]) Handle(ctx context.Context, event E) error {
+	return fn(ctx, event)
+}
+
+// Publish publishes the event to every Handler[E] resolved
+// from the ServiceGetter attached to the context with the di.ContextWith function.
+//
+// The event is dispatched by the Bus resolved from the ServiceGetter,
+// the events are dispatched synchronously if no Bus is added to the Container.
+// The async Bus added with the WithBus option resolves the handlers when the event is dispatched.
+// The event without handlers is ignored
+func Publish[E any](ctx context.Context, event E) error {
+	sg, ok := di.FromContext(ctx)
+	if !ok {
+		return di.ErrNoServiceGetter
+	}
+
+	bus, err := di.GetServiceCtx[*Bus](ctx, sg)
+	if errors.Is(err, di.ErrServiceNotFound) {
+		bus = nil
+	} else if err != nil {
+		return fmt.Errorf("events: failed to resolve bus: %w", err)
+	}
+
+	if bus != nil && bus.isAsync() && bus.cont != nil {
+		return bus.publish(ctx, newScopedEnvelope(event))
+	}
+
+	handlers, err := resolveHandlers[E](ctx, sg)
+	if err != nil {
+		return err
+	} else if len(handlers) == 0 {
+		return nil
+	}
+
+	if bus == nil {
+		return dispatch(ctx, event, handlers)
+	}
+
+	return bus.publish(ctx, newEnvelope(event, handlers))
+}
+
+// resolveHandlers resolves every Handler[E] from the ServiceGetter,
+// no handlers are returned if none is added to the Container
+func resolveHandlers[E any](ctx context.Context, sg di.ServiceGetter) ([]Handler[E], error) {
+	handlers, err := di.GetServiceCtx[[]Handler[E]](ctx, sg)
+	if errors.Is(err, di.ErrServiceNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("events: failed to resolve handlers for %v: %w", reflect.TypeFor[E](), err)
+	}
+
+	return handlers, nil
+}
+
+// dispatch calls every handler with the event.
+// All the handlers are called even if some of them fail or panic,
+// the returned error joins the handlers errors
+func dispatch[E any](ctx context.Context, event E, handlers []Handler[E]) error {
+	errs := make([]error, 0, len(handlers))
+	for _, handler := range handlers {
+		errs = append(errs, handle(ctx, event, handler))
+	}
+
+	return errors.Join(errs...)
+}
+
+// handle calls the handler with the event and recovers the handler panic
+func handle[E any](ctx context.Context, event E, handler Handler[E]) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("events: handler %T panicked: %v", handler, r)
+		}
+	}()
+
+	return handler.Handle(ctx, event)
+}
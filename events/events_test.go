@@ -0,0 +1,107 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+// userCreated is the event used in the tests
+type userCreated struct {
+	id int
+}
+
+// recorder is the Handler recording the handled events
+type recorder struct {
+	handled []int
+	err     error
+}
+
+// Handle implements the Handler interface
+func (rec *recorder) Handle(ctx context.Context, event userCreated) error {
+	rec.handled = append(rec.handled, event.id)
+	return rec.err
+}
+
+// PublishSuite is the suite for testing the Publish function
+type PublishSuite struct {
+	suite.Suite
+}
+
+// TestHandlers tests that the event is dispatched to every handler
+func (suite *PublishSuite) TestHandlers() {
+	// Arrange
+	first, second := &recorder{}, &recorder{}
+	c := di.NewContainer(
+		di.WithService[Handler[userCreated]](first),
+		di.WithService[Handler[userCreated]](second),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+
+	// Act
+	err := Publish(ctx, userCreated{id: 1})
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal([]int{1}, first.handled)
+	suite.Equal([]int{1}, second.handled)
+}
+
+// TestErrorIsolation tests that the failing and panicking handlers
+// do not prevent the rest handlers from handling the event
+func (suite *PublishSuite) TestErrorIsolation() {
+	// Arrange
+	errFailed := errors.New("failed")
+	rec := &recorder{}
+	c := di.NewContainer(
+		di.WithService[Handler[userCreated]](&recorder{err: errFailed}),
+		di.WithValue[Handler[userCreated]](HandlerFunc[userCreated](func(ctx context.Context, event userCreated) error {
+			panic("boom")
+		})),
+		di.WithService[Handler[userCreated]](rec),
+	)
+
+	ctx := di.ContextWith(context.Background(), c)
+
+	// Act
+	err := Publish(ctx, userCreated{id: 1})
+
+	// Assert
+	suite.ErrorIs(err, errFailed)
+	suite.ErrorContains(err, "panicked: boom")
+	suite.Equal([]int{1}, rec.handled)
+}
+
+// TestNoHandlers tests that the event without handlers is ignored
+func (suite *PublishSuite) TestNoHandlers() {
+	// Arrange
+	ctx := di.ContextWith(context.Background(), di.NewContainer())
+
+	// Act
+	err := Publish(ctx, userCreated{id: 1})
+
+	// Assert
+	suite.NoError(err)
+}
+
+// TestNoServiceGetter tests that the error is returned
+// when no ServiceGetter is attached to the context
+func (suite *PublishSuite) TestNoServiceGetter() {
+	// Act
+	err := Publish(context.Background(), userCreated{id: 1})
+
+	// Assert
+	suite.ErrorIs(err, di.ErrNoServiceGetter)
+}
+
+// TestPublish runs the PublishSuite
+func TestPublish(t *testing.T) {
+	suite.Run(t, new(PublishSuite))
+}
@@ -0,0 +1,79 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"net/http"
+)
+
+// handler is the http.Handler running the checks resolved from the ServiceGetter
+type handler struct {
+	// sg is the ServiceGetter the checks are resolved from
+	sg di.ServiceGetter
+
+	// aggregator runs the checks
+	aggregator *Aggregator
+
+	// resolve resolves the checks
+	resolve func(ctx context.Context, sg di.ServiceGetter) ([]Check, error)
+}
+
+// LivenessHandler returns the http.Handler running the checks
+// added to the Container with the LivenessKey key.
+//
+// The liveness checks must only check the process itself, not its dependencies,
+// the handler reports up if there are no liveness checks
+func LivenessHandler(sg di.ServiceGetter, opts ...Option) http.Handler {
+	return &handler{
+		sg:         sg,
+		aggregator: NewAggregator(opts...),
+		resolve: func(ctx context.Context, sg di.ServiceGetter) ([]Check, error) {
+			return di.GetKeyedServiceCtx[[]Check](ctx, sg, LivenessKey)
+		},
+	}
+}
+
+// ReadinessHandler returns the http.Handler running the checks added to the Container without a key
+func ReadinessHandler(sg di.ServiceGetter, opts ...Option) http.Handler {
+	return &handler{
+		sg:         sg,
+		aggregator: NewAggregator(opts...),
+		resolve: func(ctx context.Context, sg di.ServiceGetter) ([]Check, error) {
+			return di.GetServiceCtx[[]Check](ctx, sg)
+		},
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+// Responds with the JSON Report and the 200 OK status if the report is up
+// or the 503 Service Unavailable status otherwise
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var report Report
+	checks, err := h.resolve(ctx, h.sg)
+	if err != nil && !errors.Is(err, di.ErrServiceNotFound) {
+		report = Report{
+			Status: StatusDown,
+			Error:  err.Error(),
+			Checks: []Result{},
+		}
+	} else {
+		report = h.aggregator.Run(ctx, checks)
+	}
+
+	status := http.StatusOK
+	if report.Status != StatusUp {
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(report)
+}
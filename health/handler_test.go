@@ -0,0 +1,109 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// HandlerSuite is the suite for testing the liveness and readiness handlers
+type HandlerSuite struct {
+	suite.Suite
+	c *di.Container
+}
+
+// SetupTest creates the Container with the liveness and readiness checks
+func (suite *HandlerSuite) SetupTest() {
+	suite.c = di.NewContainer(
+		di.WithKeyedService[Check](LivenessKey, NewCheck("process", func(ctx context.Context) error {
+			return nil
+		})),
+		di.WithService[Check](NewCheck("db", func(ctx context.Context) error {
+			return nil
+		})),
+		di.WithService[Check](NewCheck("cache", func(ctx context.Context) error {
+			return errors.New("connection refused")
+		})),
+	)
+}
+
+// serve serves the request with the handler and decodes the Report
+func (suite *HandlerSuite) serve(h http.Handler) (int, Report) {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	suite.Equal("application/json", rec.Header().Get("Content-Type"))
+
+	var report Report
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &report))
+	return rec.Code, report
+}
+
+// TestLiveness tests that the liveness handler runs only the liveness checks
+func (suite *HandlerSuite) TestLiveness() {
+	// Act
+	code, report := suite.serve(LivenessHandler(suite.c))
+
+	// Assert
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(StatusUp, report.Status)
+	if suite.Len(report.Checks, 1) {
+		suite.Equal("process", report.Checks[0].Name)
+	}
+}
+
+// TestReadiness tests that the readiness handler runs all the unkeyed checks
+func (suite *HandlerSuite) TestReadiness() {
+	// Act
+	code, report := suite.serve(ReadinessHandler(suite.c))
+
+	// Assert
+	suite.Equal(http.StatusServiceUnavailable, code)
+	suite.Equal(StatusDown, report.Status)
+	if suite.Len(report.Checks, 2) {
+		suite.Equal(StatusUp, report.Checks[0].Status)
+		suite.Equal("connection refused", report.Checks[1].Error)
+	}
+}
+
+// TestNoChecks tests that the handler without checks reports up
+func (suite *HandlerSuite) TestNoChecks() {
+	// Act
+	code, report := suite.serve(ReadinessHandler(di.NewContainer()))
+
+	// Assert
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(StatusUp, report.Status)
+}
+
+// TestResolveError tests that the handler reports down if the checks can not be resolved
+func (suite *HandlerSuite) TestResolveError() {
+	// Arrange
+	c := di.NewContainer(
+		di.WithService[Check](func() (Check, error) {
+			return nil, errors.New("no config")
+		}),
+	)
+
+	// Act
+	code, report := suite.serve(ReadinessHandler(c))
+
+	// Assert
+	suite.Equal(http.StatusServiceUnavailable, code)
+	suite.Equal(StatusDown, report.Status)
+	suite.Contains(report.Error, "no config")
+}
+
+// TestHandler runs the HandlerSuite
+func TestHandler(t *testing.T) {
+	suite.Run(t, new(HandlerSuite))
+}
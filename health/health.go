@@ -0,0 +1,218 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a single check
+	DefaultTimeout = 5 * time.Second
+
+	// LivenessKey is the key of the checks run by the liveness handler.
+	// Add the checks with di.WithKeyedService[health.Check](health.LivenessKey, check)
+	LivenessKey = "liveness"
+)
+
+var (
+	// ErrTimeout is the error reported when the check did not complete within the timeout
+	ErrTimeout = errors.New("health: check timed out")
+)
+
+// Check checks the health of a single dependency
+type Check interface {
+	// Name returns the check name reported in the Report
+	Name() string
+
+	// Check returns an error if the dependency is unhealthy
+	Check(ctx context.Context) error
+}
+
+// Timeouter is an optional interface of the Check overriding the Aggregator timeout for the check
+type Timeouter interface {
+	// Timeout returns the check timeout
+	Timeout() time.Duration
+}
+
+// funcCheck is the Check calling the function
+type funcCheck struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Name implements the Check interface
+func (c *funcCheck) Name() string {
+	return c.name
+}
+
+// Check implements the Check interface
+func (c *funcCheck) Check(ctx context.Context) error {
+	return c.fn(ctx)
+}
+
+// NewCheck creates a new Check with the provided name calling the function
+func NewCheck(name string, fn func(ctx context.Context) error) Check {
+	return &funcCheck{
+		name: name,
+		fn:   fn,
+	}
+}
+
+// Status is the health status
+type Status string
+
+const (
+	// StatusUp is the status of the healthy check or report
+	StatusUp Status = "up"
+
+	// StatusDown is the status of the unhealthy check or report
+	StatusDown Status = "down"
+)
+
+// Result is the result of a single check
+type Result struct {
+	Name     string `json:"name"`
+	Status   Status `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Report is the aggregated result of the checks.
+// The report is up if all its checks are up
+type Report struct {
+	Status Status   `json:"status"`
+	Error  string   `json:"error,omitempty"`
+	Checks []Result `json:"checks"`
+}
+
+// Option is an interface configuring the Aggregator
+type Option interface {
+	applyAggregator(*Aggregator)
+}
+
+// timeoutOption sets the Aggregator checks timeout
+type timeoutOption struct {
+	timeout time.Duration
+}
+
+// applyAggregator applies the Option
+func (opt *timeoutOption) applyAggregator(a *Aggregator) {
+	if opt.timeout <= 0 {
+		log.Panicf("[%v]: health check timeout must be positive\n", opt.timeout)
+	}
+
+	a.timeout = opt.timeout
+}
+
+// WithTimeout sets the timeout of every single check.
+//
+// Defaults to the DefaultTimeout
+func WithTimeout(timeout time.Duration) Option {
+	return &timeoutOption{
+		timeout: timeout,
+	}
+}
+
+// Aggregator runs the checks and aggregates their results into the Report
+type Aggregator struct {
+	// timeout is the timeout of every single check
+	timeout time.Duration
+}
+
+// NewAggregator creates a new Aggregator
+func NewAggregator(opts ...Option) *Aggregator {
+	a := &Aggregator{
+		timeout: DefaultTimeout,
+	}
+
+	for _, opt := range opts {
+		opt.applyAggregator(a)
+	}
+
+	return a
+}
+
+// Run runs the checks concurrently and returns the Report with the results in the checks order.
+//
+// Every check runs with its own timeout: the Aggregator timeout
+// or the timeout returned by the check implementing the Timeouter interface.
+// The check not returning within the timeout is reported down with the ErrTimeout
+// even if it ignores the context
+func (a *Aggregator) Run(ctx context.Context, checks []Check) Report {
+	report := Report{
+		Status: StatusUp,
+		Checks: make([]Result, len(checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Checks[i] = a.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	for _, res := range report.Checks {
+		if res.Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+
+	return report
+}
+
+// run runs the single check with the timeout
+func (a *Aggregator) run(ctx context.Context, check Check) Result {
+	timeout := a.timeout
+	if t, ok := check.(Timeouter); ok && t.Timeout() > 0 {
+		timeout = t.Timeout()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("health: check panicked: %v", r)
+			}
+		}()
+
+		done <- check.Check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = ErrTimeout
+		}
+	}
+
+	res := Result{
+		Name:     check.Name(),
+		Status:   StatusUp,
+		Duration: time.Since(start).String(),
+	}
+
+	if err != nil {
+		res.Status = StatusDown
+		res.Error = err.Error()
+	}
+
+	return res
+}
@@ -0,0 +1,125 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package health
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+// slowCheck is the Check ignoring the context used in the tests
+type slowCheck struct {
+	timeout time.Duration
+	release chan struct{}
+}
+
+// Name implements the Check interface
+func (c *slowCheck) Name() string {
+	return "slow"
+}
+
+// Check implements the Check interface
+func (c *slowCheck) Check(ctx context.Context) error {
+	<-c.release
+	return nil
+}
+
+// Timeout implements the Timeouter interface
+func (c *slowCheck) Timeout() time.Duration {
+	return c.timeout
+}
+
+// AggregatorSuite is the suite for testing the Aggregator
+type AggregatorSuite struct {
+	suite.Suite
+}
+
+// TestUp tests that the report is up if all the checks are up
+func (suite *AggregatorSuite) TestUp() {
+	// Arrange
+	a := NewAggregator()
+	checks := []Check{
+		NewCheck("db", func(ctx context.Context) error { return nil }),
+		NewCheck("cache", func(ctx context.Context) error { return nil }),
+	}
+
+	// Act
+	report := a.Run(context.Background(), checks)
+
+	// Assert
+	suite.Equal(StatusUp, report.Status)
+	if suite.Len(report.Checks, 2) {
+		suite.Equal("db", report.Checks[0].Name)
+		suite.Equal(StatusUp, report.Checks[0].Status)
+		suite.Equal("cache", report.Checks[1].Name)
+	}
+}
+
+// TestDown tests that the report is down if any check fails or panics
+func (suite *AggregatorSuite) TestDown() {
+	// Arrange
+	a := NewAggregator()
+	checks := []Check{
+		NewCheck("db", func(ctx context.Context) error { return errors.New("connection refused") }),
+		NewCheck("cache", func(ctx context.Context) error { panic("boom") }),
+		NewCheck("queue", func(ctx context.Context) error { return nil }),
+	}
+
+	// Act
+	report := a.Run(context.Background(), checks)
+
+	// Assert
+	suite.Equal(StatusDown, report.Status)
+	suite.Equal(Result{Name: "db", Status: StatusDown, Error: "connection refused", Duration: report.Checks[0].Duration},
+		report.Checks[0])
+	suite.Equal(StatusDown, report.Checks[1].Status)
+	suite.Contains(report.Checks[1].Error, "boom")
+	suite.Equal(StatusUp, report.Checks[2].Status)
+}
+
+// TestConcurrentTimeout tests that the checks run concurrently
+// and the checks ignoring the context time out
+func (suite *AggregatorSuite) TestConcurrentTimeout() {
+	// Arrange
+	release := make(chan struct{})
+	defer close(release)
+
+	a := NewAggregator(WithTimeout(50 * time.Millisecond))
+	checks := []Check{
+		&slowCheck{release: release},
+		&slowCheck{release: release},
+		&slowCheck{release: release, timeout: 10 * time.Millisecond},
+	}
+
+	start := time.Now()
+
+	// Act
+	report := a.Run(context.Background(), checks)
+
+	// Assert
+	suite.Less(time.Since(start), time.Second)
+	suite.Equal(StatusDown, report.Status)
+	for _, res := range report.Checks {
+		suite.Equal(ErrTimeout.Error(), res.Error)
+	}
+}
+
+// TestNoChecks tests that the report without checks is up
+func (suite *AggregatorSuite) TestNoChecks() {
+	// Act
+	report := NewAggregator().Run(context.Background(), nil)
+
+	// Assert
+	suite.Equal(StatusUp, report.Status)
+	suite.Empty(report.Checks)
+}
+
+// TestAggregator runs the AggregatorSuite
+func TestAggregator(t *testing.T) {
+	suite.Run(t, new(AggregatorSuite))
+}
@@ -0,0 +1,209 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package host
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+var (
+	// ErrShutdownTimeout is the error returned when the hosted services
+	// did not stop within the shutdown timeout
+	ErrShutdownTimeout = errors.New("host: hosted services did not stop within the shutdown timeout")
+)
+
+// DefaultShutdownTimeout is the default time given to the hosted services to stop
+const DefaultShutdownTimeout = 30 * time.Second
+
+// HostedService is a long-running service run by the Host.
+//
+// Run must return when the provided context is done
+type HostedService interface {
+	// Run runs the service until the context is done
+	Run(ctx context.Context) error
+}
+
+// Builder is a Host builder
+type Builder struct {
+	// opts are the Container options
+	opts []di.Option
+
+	// shutdownTimeout is the time given to the hosted services to stop
+	shutdownTimeout time.Duration
+
+	// signals are the signals shutting the Host down
+	signals []os.Signal
+}
+
+// NewBuilder creates a new Builder with the provided Container options
+func NewBuilder(opts ...di.Option) *Builder {
+	return &Builder{
+		opts:            opts,
+		shutdownTimeout: DefaultShutdownTimeout,
+		signals:         []os.Signal{syscall.SIGINT, syscall.SIGTERM},
+	}
+}
+
+// WithServices adds the Container options to the Builder
+func (b *Builder) WithServices(opts ...di.Option) *Builder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// WithShutdownTimeout sets the time given to the hosted services to stop.
+//
+// Defaults to the DefaultShutdownTimeout
+func (b *Builder) WithShutdownTimeout(timeout time.Duration) *Builder {
+	b.shutdownTimeout = timeout
+	return b
+}
+
+// WithSignals sets the signals shutting the Host down.
+// No signals disable the signal handling, the Host is shut down only by the Run context.
+//
+// Defaults to the SIGINT and SIGTERM
+func (b *Builder) WithSignals(signals ...os.Signal) *Builder {
+	b.signals = signals
+	return b
+}
+
+// Build builds the Container and creates a new Host.
+//
+// Returns the di.ValidationError if any of the services marked with the di.ValidateOnBuild option is invalid,
+// e.g. the options registered with the config.Register function
+func (b *Builder) Build() (*Host, error) {
+	c, err := di.NewBuilder(b.opts...).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Host{
+		cont:            c,
+		shutdownTimeout: b.shutdownTimeout,
+		signals:         b.signals,
+	}, nil
+}
+
+// Host runs the hosted services registered in the Container
+type Host struct {
+	// cont is the Host's Container
+	cont *di.Container
+
+	// shutdownTimeout is the time given to the hosted services to stop
+	shutdownTimeout time.Duration
+
+	// signals are the signals shutting the Host down
+	signals []os.Signal
+}
+
+// Container returns the Host's Container
+func (h *Host) Container() *di.Container {
+	return h.cont
+}
+
+// Run starts the Container, runs all the services registered as HostedService
+// and blocks until the context is done, one of the shutdown signals is received,
+// any of the hosted services fails or all of them return.
+// Without the hosted services Run blocks until the context is done or one of the shutdown signals is received,
+// so the services started by the Container keep running.
+//
+// On shutdown the hosted services context is cancelled and the services are given
+// the shutdown timeout to return, then the Container is stopped and closed
+func (h *Host) Run(ctx context.Context) error {
+	// signal.NotifyContext without signals relays all the incoming signals,
+	// including the SIGURG the runtime uses for the goroutines preemption
+	if len(h.signals) > 0 {
+		var stopSignals context.CancelFunc
+		ctx, stopSignals = signal.NotifyContext(ctx, h.signals...)
+		defer stopSignals()
+	}
+
+	if err := h.cont.Start(ctx); err != nil {
+		return errors.Join(err, h.cont.Close())
+	}
+
+	services, err := di.GetServiceCtx[[]HostedService](ctx, h.cont)
+	if err != nil && !errors.Is(err, di.ErrServiceNotFound) {
+		return errors.Join(err, h.shutdown(ctx))
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, len(services))
+	for _, service := range services {
+		go func() {
+			errCh <- service.Run(runCtx)
+		}()
+	}
+
+	var errs []error
+	running := len(services)
+	if running == 0 {
+		<-runCtx.Done()
+	}
+
+wait:
+	for running > 0 {
+		select {
+		case err = <-errCh:
+			running--
+			if err = serviceError(err); err != nil {
+				errs = append(errs, err)
+				break wait
+			}
+		case <-runCtx.Done():
+			break wait
+		}
+	}
+
+	cancel()
+	errs = append(errs, h.waitServices(errCh, running))
+
+	return errors.Join(append(errs, h.shutdown(ctx))...)
+}
+
+// waitServices waits for the running hosted services to return within the shutdown timeout
+func (h *Host) waitServices(errCh <-chan error, running int) error {
+	timer := time.NewTimer(h.shutdownTimeout)
+	defer timer.Stop()
+
+	var errs []error
+	for ; running > 0; running-- {
+		select {
+		case err := <-errCh:
+			errs = append(errs, serviceError(err))
+		case <-timer.C:
+			return errors.Join(append(errs, ErrShutdownTimeout)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// serviceError wraps the hosted service error,
+// returns nil if the service returned due to the context cancellation
+func serviceError(err error) error {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	return fmt.Errorf("host: hosted service failed: %w", err)
+}
+
+// shutdown stops and closes the Container within the shutdown timeout
+func (h *Host) shutdown(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), h.shutdownTimeout)
+	defer cancel()
+
+	return errors.Join(h.cont.Stop(ctx), h.cont.Close())
+}
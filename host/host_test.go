@@ -0,0 +1,224 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package host
+
+import (
+	"context"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// blockingService is the HostedService running until the context is done
+type blockingService struct {
+	started atomic.Bool
+	stopped atomic.Bool
+}
+
+// Run implements the HostedService interface
+func (s *blockingService) Run(ctx context.Context) error {
+	s.started.Store(true)
+	<-ctx.Done()
+	s.stopped.Store(true)
+	return ctx.Err()
+}
+
+// failingService is the HostedService failing immediately
+type failingService struct{}
+
+// Run implements the HostedService interface
+func (failingService) Run(context.Context) error {
+	return errors.ErrUnsupported
+}
+
+// stuckService is the HostedService ignoring the context
+type stuckService struct{}
+
+// Run implements the HostedService interface
+func (stuckService) Run(context.Context) error {
+	select {}
+}
+
+// starter is the di.Starter implementation used in the tests
+type starter struct {
+	started atomic.Bool
+}
+
+// Start implements the di.Starter interface
+func (s *starter) Start(context.Context) error {
+	s.started.Store(true)
+	return nil
+}
+
+// closer is the io.Closer implementation used in the tests
+type closer struct {
+	closed bool
+}
+
+// Close implements the io.Closer interface
+func (c *closer) Close() error {
+	c.closed = true
+	return nil
+}
+
+// HostSuite is the suite for testing the Host
+type HostSuite struct {
+	suite.Suite
+}
+
+// TestContextCancelled tests the Host shuts down when the context is done
+func (suite *HostSuite) TestContextCancelled() {
+	// Arrange
+	service, res := &blockingService{}, &closer{}
+	h, err := NewBuilder(
+		di.WithService[HostedService](service),
+		di.WithFactory(func() *closer { return res }),
+	).Build()
+	suite.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	// Act
+	err = h.Run(ctx)
+
+	// Assert
+	suite.NoError(err)
+	suite.True(service.started.Load())
+	suite.True(service.stopped.Load())
+	suite.True(res.closed)
+}
+
+// TestSignal tests the Host shuts down on the shutdown signal
+func (suite *HostSuite) TestSignal() {
+	// Arrange
+	service := &blockingService{}
+	h, err := NewBuilder(di.WithService[HostedService](service)).
+		WithSignals(syscall.SIGUSR1).
+		Build()
+	suite.Require().NoError(err)
+
+	time.AfterFunc(20*time.Millisecond, func() {
+		_ = syscall.Kill(os.Getpid(), syscall.SIGUSR1)
+	})
+
+	// Act
+	err = h.Run(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.True(service.stopped.Load())
+}
+
+// TestNoSignals tests the Host ignores the signals when the signal handling is disabled
+func (suite *HostSuite) TestNoSignals() {
+	// Arrange
+	service := &blockingService{}
+	h, err := NewBuilder(di.WithService[HostedService](service)).
+		WithSignals().
+		Build()
+	suite.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, func() {
+		_ = syscall.Kill(os.Getpid(), syscall.SIGURG)
+	})
+	time.AfterFunc(80*time.Millisecond, cancel)
+
+	// Act
+	start := time.Now()
+	err = h.Run(ctx)
+
+	// Assert
+	suite.NoError(err)
+	suite.GreaterOrEqual(time.Since(start), 80*time.Millisecond)
+}
+
+// TestServiceFailed tests the Host shuts down when a hosted service fails
+func (suite *HostSuite) TestServiceFailed() {
+	// Arrange
+	service := &blockingService{}
+	h, err := NewBuilder(
+		di.WithService[HostedService](service),
+		di.WithService[HostedService](failingService{}),
+	).Build()
+	suite.Require().NoError(err)
+
+	// Act
+	err = h.Run(context.Background())
+
+	// Assert
+	suite.ErrorIs(err, errors.ErrUnsupported)
+	suite.True(service.stopped.Load())
+}
+
+// TestShutdownTimeout tests the Host does not wait for the stuck services longer than the timeout
+func (suite *HostSuite) TestShutdownTimeout() {
+	// Arrange
+	h, err := NewBuilder(di.WithService[HostedService](stuckService{})).
+		WithShutdownTimeout(10 * time.Millisecond).
+		Build()
+	suite.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	// Act
+	err = h.Run(ctx)
+
+	// Assert
+	suite.ErrorIs(err, ErrShutdownTimeout)
+}
+
+// TestNoServices tests the Host without the hosted services runs the started services
+// until the context is done
+func (suite *HostSuite) TestNoServices() {
+	// Arrange
+	svc, res := &starter{}, &closer{}
+	h, err := NewBuilder(
+		di.WithFactory(func() *starter { return svc }),
+		di.WithFactory(func() *closer { return res }),
+	).WithSignals().Build()
+	suite.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	// Act
+	start := time.Now()
+	err = h.Run(ctx)
+
+	// Assert
+	suite.NoError(err)
+	suite.True(svc.started.Load())
+	suite.True(res.closed)
+	suite.GreaterOrEqual(time.Since(start), 50*time.Millisecond)
+}
+
+// TestBuildInvalid tests that the Build returns the validation error
+func (suite *HostSuite) TestBuildInvalid() {
+	// Arrange
+	errInvalid := errors.New("invalid")
+	b := NewBuilder(di.WithFactory(func() (*closer, error) {
+		return nil, errInvalid
+	}, di.ValidateOnBuild()))
+
+	// Act
+	h, err := b.Build()
+
+	// Assert
+	suite.Nil(h)
+	suite.ErrorIs(err, errInvalid)
+}
+
+// TestHost tests the Host
+func TestHost(t *testing.T) {
+	suite.Run(t, new(HostSuite))
+}
@@ -0,0 +1,100 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+// Package value parses the raw string values into the reflected values
+package value
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// textUnmarshalerType is the encoding.TextUnmarshaler type
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+	// durationType is the time.Duration type
+	durationType = reflect.TypeFor[time.Duration]()
+)
+
+// IsStruct returns true if the addressable value is a struct
+// which does not implement the encoding.TextUnmarshaler interface
+func IsStruct(val reflect.Value) bool {
+	return val.Kind() == reflect.Struct && !val.Addr().Type().Implements(textUnmarshalerType)
+}
+
+// Set parses the raw value and sets it to the provided settable value.
+//
+// Supports the encoding.TextUnmarshaler implementations, time.Duration,
+// strings, booleans, numbers and slices of them parsed from the comma-separated raw values
+func Set(val reflect.Value, raw string) error {
+	if val.CanAddr() && val.Addr().Type().Implements(textUnmarshalerType) {
+		return val.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw))
+	}
+
+	if val.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+
+		val.SetInt(int64(d))
+		return nil
+	}
+
+	switch val.Kind() {
+	case reflect.String:
+		val.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+
+		val.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		val.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		val.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		val.SetFloat(f)
+	case reflect.Slice:
+		var parts []string
+		if raw != "" {
+			parts = strings.Split(raw, ",")
+		}
+
+		slice := reflect.MakeSlice(val.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := Set(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+
+		val.Set(slice)
+	default:
+		return fmt.Errorf("unsupported type %q", val.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package value
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestSet tests the Set function
+func TestSet(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want any
+	}{
+		{name: "string", raw: "value", want: "value"},
+		{name: "bool", raw: "true", want: true},
+		{name: "int", raw: "-42", want: -42},
+		{name: "uint8", raw: "42", want: uint8(42)},
+		{name: "float", raw: "0.5", want: 0.5},
+		{name: "duration", raw: "1m", want: time.Minute},
+		{name: "slice", raw: "1, 2", want: []int{1, 2}},
+		{name: "text unmarshaler", raw: "127.0.0.1", want: netip.MustParseAddr("127.0.0.1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			val := reflect.New(reflect.TypeOf(tt.want)).Elem()
+
+			// Act
+			err := Set(val, tt.raw)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, val.Interface())
+		})
+	}
+}
+
+// TestSetInvalid tests the invalid raw value is rejected
+func TestSetInvalid(t *testing.T) {
+	// Arrange
+	val := reflect.New(reflect.TypeFor[int8]()).Elem()
+
+	// Act
+	err := Set(val, "1000")
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,119 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package mediator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+)
+
+// Handler handles the requests of the Req type
+type Handler[Req, Resp any] interface {
+	// Handle handles the request and returns the response
+	Handle(ctx context.Context, req Req) (Resp, error)
+}
+
+// HandlerFunc is the function implementing the Handler interface
+type HandlerFunc[Req, Resp any] func(ctx context.Context, req Req) (Resp, error)
+
+// Handle implements the Handler interface
+func (fn HandlerFunc[Req, Resp]) Handle(ctx context.Context, req Req) (Resp, error) {
+	return fn(ctx, req)
+}
+
+// Next calls the next Behavior in the pipeline or the request Handler
+type Next func(ctx context.Context) (any, error)
+
+// Behavior wraps the handling of every request sent with the Send function,
+// e.g. for validation, logging or transactions.
+// The behaviors are resolved from the ServiceGetter as []Behavior
+// and are called in the registration order
+type Behavior interface {
+	// Handle handles the request and calls next to continue the pipeline
+	Handle(ctx context.Context, req any, next Next) (any, error)
+}
+
+// BehaviorFunc is the function implementing the Behavior interface
+type BehaviorFunc func(ctx context.Context, req any, next Next) (any, error)
+
+// Handle implements the Behavior interface
+func (fn BehaviorFunc) Handle(ctx context.Context, req any, next Next) (any, error) {
+	return fn(ctx, req, next)
+}
+
+// NotificationHandler handles the notifications of the N type
+type NotificationHandler[N any] interface {
+	// Handle handles the notification
+	Handle(ctx context.Context, notification N) error
+}
+
+// NotificationHandlerFunc is the function implementing the NotificationHandler interface
+type NotificationHandlerFunc[N any] func(ctx context.Context, notification N) error
+
+// Handle implements the NotificationHandler interface
+func (fn NotificationHandlerFunc[N]) Handle(ctx context.Context, notification N) error {
+	return fn(ctx, notification)
+}
+
+// Send sends the request to the Handler[Req, Resp] resolved from the ServiceGetter
+// through the pipeline of the behaviors
+func Send[Req, Resp any](ctx context.Context, sg di.ServiceGetter, req Req) (Resp, error) {
+	var resp Resp
+
+	handler, err := di.GetServiceCtx[Handler[Req, Resp]](ctx, sg)
+	if err != nil {
+		return resp, fmt.Errorf("mediator: failed to resolve handler for %T: %w", req, err)
+	}
+
+	behaviors, err := di.GetServiceCtx[[]Behavior](ctx, sg)
+	if err != nil && !errors.Is(err, di.ErrServiceNotFound) {
+		return resp, fmt.Errorf("mediator: failed to resolve behaviors: %w", err)
+	}
+
+	next := Next(func(ctx context.Context) (any, error) {
+		return handler.Handle(ctx, req)
+	})
+
+	for i := len(behaviors) - 1; i >= 0; i-- {
+		behavior, inner := behaviors[i], next
+		next = func(ctx context.Context) (any, error) {
+			return behavior.Handle(ctx, req, inner)
+		}
+	}
+
+	res, err := next(ctx)
+	if res == nil {
+		return resp, err
+	}
+
+	resp, ok := res.(Resp)
+	if !ok {
+		return resp, fmt.Errorf("mediator: behavior returned %T instead of %T", res, resp)
+	}
+
+	return resp, err
+}
+
+// Publish publishes the notification to every NotificationHandler[N] resolved from the ServiceGetter.
+// All the handlers are called in the registration order even if some of them fail,
+// the returned error joins the handlers errors.
+// The notification without handlers is ignored
+func Publish[N any](ctx context.Context, sg di.ServiceGetter, notification N) error {
+	handlers, err := di.GetServiceCtx[[]NotificationHandler[N]](ctx, sg)
+	if errors.Is(err, di.ErrServiceNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("mediator: failed to resolve notification handlers for %T: %w", notification, err)
+	}
+
+	errs := make([]error, 0, len(handlers))
+	for _, handler := range handlers {
+		errs = append(errs, handler.Handle(ctx, notification))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,223 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package mediator
+
+import (
+	"context"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+// getUserQuery is the request used in the tests
+type getUserQuery struct {
+	id int
+}
+
+// user is the response used in the tests
+type user struct {
+	id   int
+	name string
+}
+
+// getUserHandler is the Handler used in the tests
+type getUserHandler struct {
+	users map[int]string
+}
+
+// Handle implements the Handler interface
+func (h *getUserHandler) Handle(ctx context.Context, query getUserQuery) (user, error) {
+	name, ok := h.users[query.id]
+	if !ok {
+		return user{}, errors.New("user not found")
+	}
+
+	return user{id: query.id, name: name}, nil
+}
+
+// userCreated is the notification used in the tests
+type userCreated struct {
+	id int
+}
+
+// SendSuite is the suite for testing the Send function
+type SendSuite struct {
+	suite.Suite
+	handler di.Option
+}
+
+// SetupTest creates the getUserHandler option
+func (suite *SendSuite) SetupTest() {
+	suite.handler = di.WithService[Handler[getUserQuery, user]](&getUserHandler{
+		users: map[int]string{1: "John"},
+	})
+}
+
+// TestHandler tests that the request is handled by the registered Handler
+func (suite *SendSuite) TestHandler() {
+	// Arrange
+	c := di.NewContainer(suite.handler)
+
+	// Act
+	resp, err := Send[getUserQuery, user](context.Background(), c, getUserQuery{id: 1})
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(user{id: 1, name: "John"}, resp)
+}
+
+// TestHandlerError tests that the Handler error is returned
+func (suite *SendSuite) TestHandlerError() {
+	// Arrange
+	c := di.NewContainer(suite.handler)
+
+	// Act
+	_, err := Send[getUserQuery, user](context.Background(), c, getUserQuery{id: 2})
+
+	// Assert
+	suite.EqualError(err, "user not found")
+}
+
+// TestHandlerNotFound tests that the error is returned when no Handler is registered
+func (suite *SendSuite) TestHandlerNotFound() {
+	// Arrange
+	c := di.NewContainer()
+
+	// Act
+	_, err := Send[getUserQuery, user](context.Background(), c, getUserQuery{id: 1})
+
+	// Assert
+	suite.ErrorIs(err, di.ErrServiceNotFound)
+}
+
+// TestBehaviors tests that the behaviors wrap the Handler in the registration order
+func (suite *SendSuite) TestBehaviors() {
+	// Arrange
+	var calls []string
+	behavior := func(name string) Behavior {
+		return BehaviorFunc(func(ctx context.Context, req any, next Next) (any, error) {
+			calls = append(calls, name+":before")
+			res, err := next(ctx)
+			calls = append(calls, name+":after")
+			return res, err
+		})
+	}
+
+	c := di.NewContainer(
+		suite.handler,
+		di.WithValue[Behavior](behavior("logging")),
+		di.WithValue[Behavior](behavior("transaction")),
+	)
+
+	// Act
+	resp, err := Send[getUserQuery, user](context.Background(), c, getUserQuery{id: 1})
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal("John", resp.name)
+	suite.Equal([]string{
+		"logging:before",
+		"transaction:before",
+		"transaction:after",
+		"logging:after",
+	}, calls)
+}
+
+// TestBehaviorShortCircuit tests that the behavior may stop the pipeline
+func (suite *SendSuite) TestBehaviorShortCircuit() {
+	// Arrange
+	errInvalid := errors.New("invalid request")
+	c := di.NewContainer(
+		suite.handler,
+		di.WithValue[Behavior](BehaviorFunc(func(ctx context.Context, req any, next Next) (any, error) {
+			if query, ok := req.(getUserQuery); ok && query.id <= 0 {
+				return nil, errInvalid
+			}
+
+			return next(ctx)
+		})),
+	)
+
+	// Act
+	_, err := Send[getUserQuery, user](context.Background(), c, getUserQuery{id: 0})
+
+	// Assert
+	suite.ErrorIs(err, errInvalid)
+}
+
+// TestBehaviorInvalidResponse tests that the error is returned
+// when the behavior replaces the response with the value of another type
+func (suite *SendSuite) TestBehaviorInvalidResponse() {
+	// Arrange
+	c := di.NewContainer(
+		suite.handler,
+		di.WithValue[Behavior](BehaviorFunc(func(ctx context.Context, req any, next Next) (any, error) {
+			return "unexpected", nil
+		})),
+	)
+
+	// Act
+	_, err := Send[getUserQuery, user](context.Background(), c, getUserQuery{id: 1})
+
+	// Assert
+	suite.Error(err)
+}
+
+// TestSend runs the SendSuite
+func TestSend(t *testing.T) {
+	suite.Run(t, new(SendSuite))
+}
+
+// PublishSuite is the suite for testing the Publish function
+type PublishSuite struct {
+	suite.Suite
+}
+
+// TestHandlers tests that the notification is handled by every handler
+// even if some of them fail
+func (suite *PublishSuite) TestHandlers() {
+	// Arrange
+	var handled []int
+	errFailed := errors.New("failed")
+	c := di.NewContainer(
+		di.WithValue[NotificationHandler[userCreated]](NotificationHandlerFunc[userCreated](
+			func(ctx context.Context, n userCreated) error {
+				handled = append(handled, n.id)
+				return errFailed
+			},
+		)),
+		di.WithValue[NotificationHandler[userCreated]](NotificationHandlerFunc[userCreated](
+			func(ctx context.Context, n userCreated) error {
+				handled = append(handled, n.id*10)
+				return nil
+			},
+		)),
+	)
+
+	// Act
+	err := Publish(context.Background(), c, userCreated{id: 1})
+
+	// Assert
+	suite.ErrorIs(err, errFailed)
+	suite.Equal([]int{1, 10}, handled)
+}
+
+// TestNoHandlers tests that the notification without handlers is ignored
+func (suite *PublishSuite) TestNoHandlers() {
+	// Arrange
+	c := di.NewContainer()
+
+	// Act
+	err := Publish(context.Background(), c, userCreated{id: 1})
+
+	// Assert
+	suite.NoError(err)
+}
+
+// TestPublish runs the PublishSuite
+func TestPublish(t *testing.T) {
+	suite.Run(t, new(PublishSuite))
+}
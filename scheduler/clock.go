@@ -0,0 +1,30 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package scheduler
+
+import "time"
+
+// Clock is the time source of the Scheduler.
+// Replace it with the fake implementation to test the jobs without sleeping
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+
+	// After returns the channel receiving the current time after the duration
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the Clock using the system time
+type systemClock struct{}
+
+// Now implements the Clock interface
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After implements the Clock interface
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,311 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidSpec is the error returned when the schedule spec can not be parsed
+	ErrInvalidSpec = errors.New("scheduler: invalid schedule spec")
+)
+
+// Schedule describes the job run times
+type Schedule interface {
+	// Next returns the next run time after the provided time.
+	// Returns the zero time if the job must not run anymore
+	Next(after time.Time) time.Time
+}
+
+// intervalSchedule runs the job with the fixed interval
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+// Next implements the Schedule interface
+func (s *intervalSchedule) Next(after time.Time) time.Time {
+	return after.Add(s.interval)
+}
+
+// Every returns the Schedule running the job with the fixed interval
+// counted from the end of the previous run.
+//
+// Panics if the interval is not positive
+func Every(interval time.Duration) Schedule {
+	if interval <= 0 {
+		log.Panicf("[%v]: schedule interval must be positive\n", interval)
+	}
+
+	return &intervalSchedule{
+		interval: interval,
+	}
+}
+
+// cronField is the cron expression field bounds
+type cronField struct {
+	min, max int
+
+	// names are the optional value names, e.g. the months and the weekdays names
+	names map[string]int
+}
+
+var (
+	secondField = cronField{min: 0, max: 59}
+	minuteField = cronField{min: 0, max: 59}
+	hourField   = cronField{min: 0, max: 23}
+	domField    = cronField{min: 1, max: 31}
+	monthField  = cronField{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = cronField{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// cronMacros are the predefined cron expressions
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// cronSchedule runs the job at the times matching the cron expression.
+// Every field is the bit set of the matching values
+type cronSchedule struct {
+	second, minute, hour, dom, month, dow uint64
+
+	// domAny and dowAny are true if the day of month or the day of week field is the wildcard
+	domAny, dowAny bool
+}
+
+// ParseCron parses the standard 5-field cron expression
+// (minute, hour, day of month, month, day of week)
+// or the 6-field cron expression with the leading seconds field.
+//
+// Every field accepts the wildcards (* or ?), the values, the ranges (1-5), the steps (*/15, 1-30/5)
+// and the comma-separated lists of them. The months and the weekdays accept the three-letter names.
+// The day of week accepts both 0 and 7 for Sunday.
+//
+// The @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly macros are supported
+// as well as "@every <duration>" equal to the Every schedule
+func ParseCron(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if interval, ok := strings.CutPrefix(spec, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(interval))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("%w %q: invalid interval", ErrInvalidSpec, spec)
+		}
+
+		return Every(d), nil
+	}
+
+	expr := spec
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("%w %q: expected 5 or 6 fields, got %d", ErrInvalidSpec, spec, len(fields))
+	}
+
+	s := &cronSchedule{
+		domAny: isWildcard(fields[3]),
+		dowAny: isWildcard(fields[5]),
+	}
+
+	for i, target := range []struct {
+		bits  *uint64
+		field cronField
+	}{
+		{&s.second, secondField},
+		{&s.minute, minuteField},
+		{&s.hour, hourField},
+		{&s.dom, domField},
+		{&s.month, monthField},
+		{&s.dow, dowField},
+	} {
+		bits, err := target.field.parse(fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %v", ErrInvalidSpec, spec, err)
+		}
+
+		*target.bits = bits
+	}
+
+	// Sunday is both 0 and 7
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+// MustParseCron parses the cron expression like the ParseCron function.
+//
+// Panics if the cron expression is invalid
+func MustParseCron(spec string) Schedule {
+	s, err := ParseCron(spec)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	return s
+}
+
+// isWildcard reports whether the field matches any value
+func isWildcard(field string) bool {
+	return field == "*" || field == "?"
+}
+
+// parse parses the cron field into the bit set of the matching values
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+
+		var start, end int
+		switch {
+		case isWildcard(rng):
+			start, end = f.min, f.max
+		case strings.Contains(rng, "-"):
+			lo, hi, _ := strings.Cut(rng, "-")
+
+			var err error
+			if start, err = f.value(lo); err != nil {
+				return 0, err
+			}
+			if end, err = f.value(hi); err != nil {
+				return 0, err
+			}
+		default:
+			var err error
+			if start, err = f.value(rng); err != nil {
+				return 0, err
+			}
+
+			end = start
+			if hasStep {
+				end = f.max
+			}
+		}
+
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q", part)
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+// value parses the single field value or name
+func (f cronField) value(raw string) (int, error) {
+	if v, ok := f.names[strings.ToLower(raw)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %q out of range [%d, %d]", raw, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// has reports whether the value is in the bit set
+func has(bits uint64, v int) bool {
+	return bits&(1<<v) != 0
+}
+
+// Next implements the Schedule interface
+func (s *cronSchedule) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Second).Add(time.Second)
+
+	// the expression never matching, e.g. February 30, is checked within five years
+	yearLimit := t.Year() + 5
+
+wrap:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for !has(s.month, int(t.Month())) {
+		t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !s.dayMatches(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+
+	for !has(s.hour, t.Hour()) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+
+	for !has(s.minute, t.Minute()) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+
+	for !has(s.second, t.Second()) {
+		t = t.Add(time.Second)
+		if t.Second() == 0 {
+			goto wrap
+		}
+	}
+
+	return t
+}
+
+// dayMatches reports whether the day matches the day of month and the day of week fields.
+// If both fields are restricted, the day matching any of them matches
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := has(s.dom, t.Day())
+	dowMatch := has(s.dow, int(t.Weekday()))
+
+	if s.domAny || s.dowAny {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
@@ -0,0 +1,102 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package scheduler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// date returns the UTC time used in the tests
+func date(year int, month time.Month, day, hour, minute, second int) time.Time {
+	return time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+}
+
+// TestParseCron tests the cron expressions next run times
+func TestParseCron(t *testing.T) {
+	// 2024-01-15 is Monday
+	from := date(2024, time.January, 15, 10, 30, 15)
+
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", date(2024, time.January, 15, 10, 31, 0)},
+		{"*/15 * * * *", date(2024, time.January, 15, 10, 45, 0)},
+		{"0 12 * * *", date(2024, time.January, 15, 12, 0, 0)},
+		{"0 9 * * *", date(2024, time.January, 16, 9, 0, 0)},
+		{"0 0 1 * *", date(2024, time.February, 1, 0, 0, 0)},
+		{"0 0 * * sun", date(2024, time.January, 21, 0, 0, 0)},
+		{"0 0 * * 7", date(2024, time.January, 21, 0, 0, 0)},
+		{"0 0 * * MON-FRI", date(2024, time.January, 16, 0, 0, 0)},
+		{"0 0 13 * 5", date(2024, time.January, 19, 0, 0, 0)},
+		{"0 0 29 feb *", date(2024, time.February, 29, 0, 0, 0)},
+		{"30 8,20 * * *", date(2024, time.January, 15, 20, 30, 0)},
+		{"*/20 * * * * *", date(2024, time.January, 15, 10, 30, 20)},
+		{"0 0 0 1 1 *", date(2025, time.January, 1, 0, 0, 0)},
+		{"@hourly", date(2024, time.January, 15, 11, 0, 0)},
+		{"@daily", date(2024, time.January, 16, 0, 0, 0)},
+		{"@weekly", date(2024, time.January, 21, 0, 0, 0)},
+		{"@every 90s", date(2024, time.January, 15, 10, 31, 45)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			// Arrange
+			s, err := ParseCron(tt.spec)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			// Act
+			next := s.Next(from)
+
+			// Assert
+			assert.Equal(t, tt.next, next)
+		})
+	}
+}
+
+// TestParseCronInvalid tests that the invalid cron expressions are rejected
+func TestParseCronInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@every -1s",
+		"@every never",
+	} {
+		t.Run(spec, func(t *testing.T) {
+			// Act
+			_, err := ParseCron(spec)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidSpec)
+		})
+	}
+}
+
+// TestEvery tests the interval Schedule
+func TestEvery(t *testing.T) {
+	// Arrange
+	from := date(2024, time.January, 15, 10, 30, 15)
+	s := Every(time.Hour)
+
+	// Act
+	next := s.Next(from)
+
+	// Assert
+	assert.Equal(t, from.Add(time.Hour), next)
+	assert.Panics(t, func() { Every(0) })
+}
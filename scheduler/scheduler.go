@@ -0,0 +1,255 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"log"
+	"reflect"
+	"sync"
+)
+
+var (
+	// ErrAlreadyRunning is the error returned when the Scheduler is started twice
+	ErrAlreadyRunning = errors.New("scheduler: already running")
+)
+
+// Job is the recurring job run by the Scheduler
+type Job interface {
+	// Run runs the job
+	Run(ctx context.Context) error
+}
+
+// JobFunc is the function implementing the Job interface
+type JobFunc func(ctx context.Context) error
+
+// Run implements the Job interface
+func (fn JobFunc) Run(ctx context.Context) error {
+	return fn(ctx)
+}
+
+// ErrorHandler handles the errors of the job runs
+type ErrorHandler func(ctx context.Context, job string, err error)
+
+// jobEntry is the scheduled job
+type jobEntry struct {
+	// name is the job service type name
+	name string
+
+	// schedule is the job Schedule
+	schedule Schedule
+
+	// resolve resolves the job service
+	resolve func(ctx context.Context, sg di.ServiceGetter) (Job, error)
+}
+
+// WithJob adds the job service of the J type run with the provided Schedule to the Container.
+//
+// The job service itself must be added to the Container separately.
+// The job is resolved from a new scope on every run,
+// so the Scoped and Transient jobs get the new dependencies for every run
+func WithJob[J Job](schedule Schedule) di.Option {
+	return di.WithValue(&jobEntry{
+		name:     reflect.TypeFor[J]().String(),
+		schedule: schedule,
+		resolve: func(ctx context.Context, sg di.ServiceGetter) (Job, error) {
+			return di.GetServiceCtx[J](ctx, sg)
+		},
+	})
+}
+
+// Option is an interface configuring the Scheduler
+type Option interface {
+	applyScheduler(*Scheduler)
+}
+
+// clockOption sets the Scheduler Clock
+type clockOption struct {
+	clock Clock
+}
+
+// applyScheduler applies the Option
+func (opt *clockOption) applyScheduler(s *Scheduler) {
+	s.clock = opt.clock
+}
+
+// WithClock sets the Clock used by the Scheduler.
+//
+// Defaults to the system clock
+func WithClock(clock Clock) Option {
+	return &clockOption{
+		clock: clock,
+	}
+}
+
+// errorHandlerOption sets the Scheduler ErrorHandler
+type errorHandlerOption struct {
+	handler ErrorHandler
+}
+
+// applyScheduler applies the Option
+func (opt *errorHandlerOption) applyScheduler(s *Scheduler) {
+	s.onError = opt.handler
+}
+
+// WithErrorHandler sets the ErrorHandler called with the errors of the job runs.
+//
+// The errors are logged by default
+func WithErrorHandler(handler ErrorHandler) Option {
+	return &errorHandlerOption{
+		handler: handler,
+	}
+}
+
+// Scheduler runs the jobs added to the Container with the WithJob function.
+//
+// Every job runs in its own goroutine, the next run time is computed after the previous run ends,
+// so the runs of the same job never overlap and the missed runs are skipped.
+// The Scheduler implements the di.Starter and di.Stopper interfaces
+// and is started and stopped with the Container
+type Scheduler struct {
+	// c is the Container the jobs are resolved from
+	c *di.Container
+
+	// clock is the Scheduler time source
+	clock Clock
+
+	// onError handles the job runs errors
+	onError ErrorHandler
+
+	// mu guards the cancel function
+	mu sync.Mutex
+
+	// cancel cancels the running jobs, nil if the Scheduler is not running
+	cancel context.CancelFunc
+
+	// wg waits for the jobs goroutines
+	wg sync.WaitGroup
+}
+
+// New creates a new Scheduler running the jobs added to the Container
+func New(c *di.Container, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		c:       c,
+		clock:   systemClock{},
+		onError: logError,
+	}
+
+	for _, opt := range opts {
+		opt.applyScheduler(s)
+	}
+
+	return s
+}
+
+// WithScheduler adds the Scheduler created with the provided options to the Container
+func WithScheduler(opts ...Option) di.Option {
+	return di.WithFactory(func(c *di.Container) *Scheduler {
+		return New(c, opts...)
+	})
+}
+
+// Start starts running the jobs.
+//
+// The jobs run with the context detached from the provided context cancellation
+// until the Scheduler is stopped
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancel != nil {
+		return ErrAlreadyRunning
+	}
+
+	entries, err := di.GetServiceCtx[[]*jobEntry](ctx, s.c)
+	if err != nil && !errors.Is(err, di.ErrServiceNotFound) {
+		return fmt.Errorf("scheduler: failed to resolve jobs: %w", err)
+	}
+
+	ctx, s.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	for _, entry := range entries {
+		s.wg.Add(1)
+		go s.loop(ctx, entry)
+	}
+
+	return nil
+}
+
+// Stop stops scheduling the jobs, cancels the running jobs contexts
+// and waits for them to return or for the provided context to be done
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loop runs the job at the times of its Schedule until the context is done
+func (s *Scheduler) loop(ctx context.Context, entry *jobEntry) {
+	defer s.wg.Done()
+
+	for {
+		now := s.clock.Now()
+		next := entry.schedule.Next(now)
+		if next.IsZero() {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.clock.After(next.Sub(now)):
+		}
+
+		if err := s.run(ctx, entry); err != nil {
+			s.onError(ctx, entry.name, err)
+		}
+	}
+}
+
+// run resolves the job from a new scope and runs it
+func (s *Scheduler) run(ctx context.Context, entry *jobEntry) (err error) {
+	scope := s.c.NewScope()
+	defer func() {
+		err = errors.Join(err, scope.Close())
+	}()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("scheduler: job %s panicked: %v", entry.name, r)
+		}
+	}()
+
+	ctx = di.ContextWith(ctx, scope)
+	job, err := entry.resolve(ctx, scope)
+	if err != nil {
+		return fmt.Errorf("scheduler: failed to resolve job %s: %w", entry.name, err)
+	}
+
+	return job.Run(ctx)
+}
+
+// logError is the default ErrorHandler logging the error
+func logError(_ context.Context, job string, err error) {
+	log.Printf("scheduler: job %s failed: %v\n", job, err)
+}
@@ -0,0 +1,286 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeTimer is the fakeClock pending timer
+type fakeTimer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// fakeClock is the Clock advanced manually in the tests
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []fakeTimer
+
+	// waiting receives a value every time the After method is called
+	waiting chan struct{}
+}
+
+// newFakeClock creates a new fakeClock
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:     date(2024, time.January, 15, 10, 0, 0),
+		waiting: make(chan struct{}, 16),
+	}
+}
+
+// Now implements the Clock interface
+func (clock *fakeClock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	return clock.now
+}
+
+// After implements the Clock interface
+func (clock *fakeClock) After(d time.Duration) <-chan time.Time {
+	clock.mu.Lock()
+	ch := make(chan time.Time, 1)
+	clock.timers = append(clock.timers, fakeTimer{at: clock.now.Add(d), ch: ch})
+	clock.mu.Unlock()
+
+	clock.waiting <- struct{}{}
+	return ch
+}
+
+// Advance moves the clock forward and fires the due timers
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	clock.now = clock.now.Add(d)
+
+	pending := clock.timers[:0]
+	for _, timer := range clock.timers {
+		if timer.at.After(clock.now) {
+			pending = append(pending, timer)
+		} else {
+			timer.ch <- clock.now
+		}
+	}
+
+	clock.timers = pending
+}
+
+// lastID is the last countingJob id
+var lastID atomic.Int64
+
+// countingJob is the Scoped Job used in the tests
+type countingJob struct {
+	id   int64
+	runs chan<- int64
+	err  error
+}
+
+// Run implements the Job interface
+func (job *countingJob) Run(ctx context.Context) error {
+	job.runs <- job.id
+	return job.err
+}
+
+// blockingJob is the Job blocking until released or cancelled used in the tests
+type blockingJob struct {
+	started chan<- struct{}
+	release <-chan struct{}
+}
+
+// Run implements the Job interface
+func (job *blockingJob) Run(ctx context.Context) error {
+	job.started <- struct{}{}
+
+	select {
+	case <-job.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SchedulerSuite is the suite for testing the Scheduler
+type SchedulerSuite struct {
+	suite.Suite
+	clock *fakeClock
+}
+
+// SetupTest creates the fakeClock
+func (suite *SchedulerSuite) SetupTest() {
+	suite.clock = newFakeClock()
+}
+
+// start creates and starts the Container with the Scheduler and the provided options
+func (suite *SchedulerSuite) start(opts ...di.Option) *di.Container {
+	opts = append(opts, WithScheduler(WithClock(suite.clock)))
+	c := di.NewContainer(opts...)
+	suite.Require().NoError(c.Start(context.Background()))
+
+	suite.T().Cleanup(func() {
+		suite.NoError(c.Stop(context.Background()))
+	})
+
+	return c
+}
+
+// TestRunsPerScope tests that the job is resolved from a new scope on every run
+func (suite *SchedulerSuite) TestRunsPerScope() {
+	// Arrange
+	runs := make(chan int64, 2)
+	suite.start(
+		di.WithFactory(func() *countingJob {
+			return &countingJob{id: lastID.Add(1), runs: runs}
+		}, di.WithLifetime(di.Scoped)),
+		WithJob[*countingJob](Every(time.Minute)),
+	)
+
+	// Act
+	<-suite.clock.waiting
+	suite.clock.Advance(time.Minute)
+	first := <-runs
+
+	<-suite.clock.waiting
+	suite.clock.Advance(time.Minute)
+	second := <-runs
+
+	// Assert
+	suite.NotEqual(first, second)
+}
+
+// TestCron tests that the job runs at the cron expression times
+func (suite *SchedulerSuite) TestCron() {
+	// Arrange
+	runs := make(chan int64, 1)
+	suite.start(
+		di.WithService[*countingJob](&countingJob{runs: runs}),
+		WithJob[*countingJob](MustParseCron("30 10 * * *")),
+	)
+
+	<-suite.clock.waiting
+
+	// Act
+	suite.clock.Advance(29 * time.Minute)
+
+	// Assert
+	select {
+	case <-runs:
+		suite.Fail("job ran before its time")
+	default:
+	}
+
+	suite.clock.Advance(time.Minute)
+	<-runs
+}
+
+// TestNoOverlap tests that the next run is not scheduled until the previous run ends
+func (suite *SchedulerSuite) TestNoOverlap() {
+	// Arrange
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	suite.start(
+		di.WithService[*blockingJob](&blockingJob{started: started, release: release}),
+		WithJob[*blockingJob](Every(time.Second)),
+	)
+
+	<-suite.clock.waiting
+	suite.clock.Advance(time.Second)
+	<-started
+
+	// Act
+	suite.clock.Advance(10 * time.Second)
+
+	// Assert
+	select {
+	case <-started:
+		suite.Fail("job runs overlapped")
+	case <-suite.clock.waiting:
+		suite.Fail("next run scheduled before the previous run ended")
+	default:
+	}
+
+	release <- struct{}{}
+	<-suite.clock.waiting
+	suite.Empty(started)
+}
+
+// TestStopCancelsJobs tests that the Stop method cancels the running jobs
+func (suite *SchedulerSuite) TestStopCancelsJobs() {
+	// Arrange
+	started := make(chan struct{}, 1)
+	c := di.NewContainer(
+		di.WithService[*blockingJob](&blockingJob{started: started, release: make(chan struct{})}),
+		WithJob[*blockingJob](Every(time.Second)),
+		WithScheduler(WithClock(suite.clock)),
+	)
+	suite.Require().NoError(c.Start(context.Background()))
+
+	<-suite.clock.waiting
+	suite.clock.Advance(time.Second)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// Act
+	err := c.Stop(ctx)
+
+	// Assert
+	suite.NoError(err)
+}
+
+// TestErrorHandler tests that the job errors are passed to the ErrorHandler
+func (suite *SchedulerSuite) TestErrorHandler() {
+	// Arrange
+	errFailed := errors.New("failed")
+	failures := make(chan error, 1)
+	c := di.NewContainer(
+		di.WithService[*countingJob](&countingJob{runs: make(chan int64, 1), err: errFailed}),
+		WithJob[*countingJob](Every(time.Second)),
+		WithScheduler(
+			WithClock(suite.clock),
+			WithErrorHandler(func(ctx context.Context, job string, err error) {
+				failures <- err
+			}),
+		),
+	)
+	suite.Require().NoError(c.Start(context.Background()))
+	defer c.Stop(context.Background())
+
+	// Act
+	<-suite.clock.waiting
+	suite.clock.Advance(time.Second)
+	err := <-failures
+
+	// Assert
+	suite.ErrorIs(err, errFailed)
+}
+
+// TestAlreadyRunning tests that the Scheduler can not be started twice
+func (suite *SchedulerSuite) TestAlreadyRunning() {
+	// Arrange
+	c := suite.start()
+	s := di.MustGetService[*Scheduler](c)
+
+	// Act
+	err := s.Start(context.Background())
+
+	// Assert
+	suite.ErrorIs(err, ErrAlreadyRunning)
+}
+
+// TestScheduler runs the SchedulerSuite
+func TestScheduler(t *testing.T) {
+	suite.Run(t, new(SchedulerSuite))
+}
@@ -0,0 +1,133 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package web
+
+import (
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/akimsavvin/gonet/v2/dihttp"
+	"net/http"
+	"strings"
+)
+
+// Route is a Controller route
+type Route struct {
+	// Method is the route HTTP method.
+	// Empty to match any method
+	Method string
+
+	// Pattern is the http.ServeMux path pattern, e.g. "/users/{id}"
+	Pattern string
+
+	// Handler handles the route requests
+	Handler http.Handler
+
+	// Summary is the route summary used in the OpenAPI document
+	Summary string
+
+	// Enhancers are the route guards, interceptors and pipes
+	// applied after the global and the controller ones
+	Enhancers
+}
+
+// Controller declares the routes mounted by the Mount function.
+//
+// Controllers are added to the Container with the di.WithService[web.Controller] option
+type Controller interface {
+	// Routes returns the controller routes
+	Routes() []Route
+}
+
+// Prefixer is an optional Controller interface prefixing all the controller routes patterns
+type Prefixer interface {
+	// Prefix returns the controller routes patterns prefix, e.g. "/users"
+	Prefix() string
+}
+
+// Mount resolves all the controllers added to the Container and mounts their routes onto the mux.
+//
+// Every route handler is wrapped with the dihttp.Middleware, so the per-request
+// dependencies are resolved from the request scope with the dihttp.GetService function.
+// The path parameters are available with the http.Request.PathValue method.
+//
+// The global guards, interceptors and pipes added to the Container are applied to every route
+// followed by the EnhancedController ones and the route ones.
+//
+// Panics if the routes patterns conflict, the same way the http.ServeMux does
+func Mount(mux *http.ServeMux, c *di.Container) error {
+	routes, err := resolveRoutes(c)
+	if err != nil {
+		return err
+	}
+
+	scoped := dihttp.Middleware(c)
+	for _, route := range routes {
+		h := newEnhancedHandler(route.Enhancers, route.Handler)
+		mux.Handle(routePattern(route.Method, route.Path), scoped(h))
+	}
+
+	return nil
+}
+
+// mountedRoute is the controller route with the controller prefix and enhancers applied
+type mountedRoute struct {
+	Route
+
+	// Path is the route pattern prefixed with the controller prefix
+	Path string
+}
+
+// resolveRoutes resolves all the controllers added to the Container
+// and returns their routes in the registration order
+func resolveRoutes(c *di.Container) ([]mountedRoute, error) {
+	controllers, err := di.GetService[[]Controller](c)
+	if errors.Is(err, di.ErrServiceNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var routes []mountedRoute
+	for _, controller := range controllers {
+		var prefix string
+		if p, ok := controller.(Prefixer); ok {
+			prefix = strings.TrimSuffix(p.Prefix(), "/")
+		}
+
+		var enhancers Enhancers
+		if e, ok := controller.(EnhancedController); ok {
+			enhancers = e.Enhancers()
+		}
+
+		for _, route := range controller.Routes() {
+			route.Enhancers = enhancers.merge(route.Enhancers)
+			routes = append(routes, mountedRoute{
+				Route: route,
+				Path:  prefix + route.Pattern,
+			})
+		}
+	}
+
+	return routes, nil
+}
+
+// NewHandler creates a new http.ServeMux with all the controllers added to the Container mounted
+func NewHandler(c *di.Container) (http.Handler, error) {
+	mux := http.NewServeMux()
+	if err := Mount(mux, c); err != nil {
+		return nil, err
+	}
+
+	return mux, nil
+}
+
+// routePattern returns the http.ServeMux pattern for the route
+func routePattern(method, path string) string {
+	if method == "" {
+		return path
+	}
+
+	return method + " " + path
+}
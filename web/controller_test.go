@@ -0,0 +1,145 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package web
+
+import (
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/akimsavvin/gonet/v2/dihttp"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// requestID is the Scoped service used in the tests
+type requestID struct {
+	value string
+}
+
+// userController is the Controller used in the tests
+type userController struct{}
+
+// Prefix implements the Prefixer interface
+func (contr *userController) Prefix() string {
+	return "/users/"
+}
+
+// Routes implements the Controller interface
+func (contr *userController) Routes() []Route {
+	return []Route{
+		{
+			Method:  http.MethodGet,
+			Pattern: "/{id}",
+			Handler: http.HandlerFunc(contr.getUser),
+		},
+	}
+}
+
+// getUser handles the GET /users/{id} requests
+func (contr *userController) getUser(w http.ResponseWriter, r *http.Request) {
+	reqID, err := dihttp.GetService[*requestID](r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "%s:%s", r.PathValue("id"), reqID.value)
+}
+
+// healthController is the Controller without prefix used in the tests
+type healthController struct{}
+
+// Routes implements the Controller interface
+func (contr healthController) Routes() []Route {
+	return []Route{
+		{
+			Pattern: "/health",
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		},
+	}
+}
+
+// MountSuite is the suite for testing the Mount function
+type MountSuite struct {
+	suite.Suite
+}
+
+// newHandler creates a new handler with the test controllers mounted
+func (suite *MountSuite) newHandler() http.Handler {
+	c := di.NewContainer(
+		di.WithFactory(func(r *http.Request) *requestID {
+			return &requestID{value: r.Header.Get("X-Request-ID")}
+		}, di.WithLifetime(di.Scoped)),
+		di.WithService[Controller](&userController{}),
+		di.WithService[Controller](healthController{}),
+	)
+
+	h, err := NewHandler(c)
+	suite.Require().NoError(err)
+
+	return h
+}
+
+// TestPathParams tests the route path parameters and the request scope
+func (suite *MountSuite) TestPathParams() {
+	// Arrange
+	h := suite.newHandler()
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	req.Header.Set("X-Request-ID", "req")
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, req)
+
+	// Assert
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("42:req", rec.Body.String())
+}
+
+// TestMethod tests the route method is matched
+func (suite *MountSuite) TestMethod() {
+	// Arrange
+	h := suite.newHandler()
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/users/42", nil))
+
+	// Assert
+	suite.Equal(http.StatusMethodNotAllowed, rec.Code)
+}
+
+// TestAnyMethod tests the route without the method matches any method
+func (suite *MountSuite) TestAnyMethod() {
+	// Arrange
+	h := suite.newHandler()
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/health", nil))
+
+	// Assert
+	suite.Equal(http.StatusNoContent, rec.Code)
+}
+
+// TestNoControllers tests nothing is mounted without the controllers
+func (suite *MountSuite) TestNoControllers() {
+	// Arrange
+	mux := http.NewServeMux()
+
+	// Act
+	err := Mount(mux, di.NewContainer())
+
+	// Assert
+	suite.NoError(err)
+}
+
+// TestMount tests the Mount function
+func TestMount(t *testing.T) {
+	suite.Run(t, new(MountSuite))
+}
@@ -0,0 +1,260 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"net/http"
+	"reflect"
+	"slices"
+)
+
+// Guard decides whether the request may reach the route handler, e.g. by the authorization.
+//
+// Guards added to the Container with the di.WithService[web.Guard] option are applied to all the routes
+type Guard interface {
+	// CanActivate returns false to reject the request with the 403 Forbidden status
+	// or an error to reject it with the error status
+	CanActivate(r *http.Request) (bool, error)
+}
+
+// Interceptor wraps the route handler execution, e.g. for the logging and timing.
+//
+// Interceptors added to the Container with the di.WithService[web.Interceptor] option
+// are applied to all the routes
+type Interceptor interface {
+	// Intercept handles the request calling the next handler
+	Intercept(w http.ResponseWriter, r *http.Request, next http.Handler)
+}
+
+// Pipe transforms or validates the request body decoded by the Bind function.
+//
+// Pipes added to the Container with the di.WithService[web.Pipe] option are applied to all the routes
+type Pipe interface {
+	// Transform returns the transformed value or an error rejecting the request
+	// with the HTTPError status or the 400 Bad Request status and the error message
+	Transform(ctx context.Context, value any) (any, error)
+}
+
+// Ref references a guard, an interceptor or a pipe resolved from the request scope
+type Ref[E any] func(ctx context.Context) (E, error)
+
+// UseGuard returns the reference to the Guard of type T resolved from the request scope
+func UseGuard[T Guard]() Ref[Guard] {
+	return func(ctx context.Context) (Guard, error) {
+		return di.GetServiceFromContext[T](ctx)
+	}
+}
+
+// UseInterceptor returns the reference to the Interceptor of type T resolved from the request scope
+func UseInterceptor[T Interceptor]() Ref[Interceptor] {
+	return func(ctx context.Context) (Interceptor, error) {
+		return di.GetServiceFromContext[T](ctx)
+	}
+}
+
+// UsePipe returns the reference to the Pipe of type T resolved from the request scope
+func UsePipe[T Pipe]() Ref[Pipe] {
+	return func(ctx context.Context) (Pipe, error) {
+		return di.GetServiceFromContext[T](ctx)
+	}
+}
+
+// Enhancers are the guards, interceptors and pipes applied to the routes
+type Enhancers struct {
+	// Guards are called in order before the interceptors
+	Guards []Ref[Guard]
+
+	// Interceptors wrap the handler, the first one is the outermost
+	Interceptors []Ref[Interceptor]
+
+	// Pipes are applied in order to the request body decoded by the Bind function
+	Pipes []Ref[Pipe]
+}
+
+// EnhancedController is an optional Controller interface
+// applying the enhancers to all the controller routes
+type EnhancedController interface {
+	Controller
+
+	// Enhancers returns the enhancers applied to all the controller routes
+	Enhancers() Enhancers
+}
+
+// merge returns the enhancers followed by the other enhancers
+func (e Enhancers) merge(other Enhancers) Enhancers {
+	return Enhancers{
+		Guards:       slices.Concat(e.Guards, other.Guards),
+		Interceptors: slices.Concat(e.Interceptors, other.Interceptors),
+		Pipes:        slices.Concat(e.Pipes, other.Pipes),
+	}
+}
+
+// pipesKey is the request context key of the request pipes
+type pipesKey struct{}
+
+// enhancedHandler is the route handler applying the global and the route enhancers
+type enhancedHandler struct {
+	// enhancers are the controller and the route enhancers
+	enhancers Enhancers
+
+	// handler is the route handler
+	handler http.Handler
+}
+
+// newEnhancedHandler creates a new enhancedHandler
+func newEnhancedHandler(enhancers Enhancers, handler http.Handler) *enhancedHandler {
+	return &enhancedHandler{
+		enhancers: enhancers,
+		handler:   handler,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface
+func (h *enhancedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guards, err := resolveEnhancers(ctx, h.enhancers.Guards)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	for _, guard := range guards {
+		ok, err := guard.CanActivate(r)
+		if err != nil {
+			WriteError(w, err)
+			return
+		} else if !ok {
+			WriteError(w, NewHTTPError(http.StatusForbidden, http.StatusText(http.StatusForbidden)))
+			return
+		}
+	}
+
+	interceptors, err := resolveEnhancers(ctx, h.enhancers.Interceptors)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	pipes, err := resolveEnhancers(ctx, h.enhancers.Pipes)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	next := h.handler
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		next = intercepted(interceptors[i], next)
+	}
+
+	next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, pipesKey{}, pipes)))
+}
+
+// intercepted returns the handler calling the interceptor with the next handler
+func intercepted(interceptor Interceptor, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		interceptor.Intercept(w, r, next)
+	})
+}
+
+// resolveEnhancers resolves the global enhancers of type E from the request scope
+// followed by the referenced ones
+func resolveEnhancers[E any](ctx context.Context, refs []Ref[E]) ([]E, error) {
+	enhancers, err := di.GetServiceFromContext[[]E](ctx)
+	if err != nil && !errors.Is(err, di.ErrServiceNotFound) {
+		return nil, err
+	}
+
+	for _, ref := range refs {
+		enhancer, err := ref(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		enhancers = append(enhancers, enhancer)
+	}
+
+	return enhancers, nil
+}
+
+// Bind decodes the JSON request body into a new value of type T
+// and applies the request pipes to it.
+//
+// Returns the HTTPError with the 400 Bad Request status if the body is invalid
+// or a pipe rejects the value
+func Bind[T any](r *http.Request) (T, error) {
+	var value T
+	if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+		return value, &HTTPError{
+			Status:  http.StatusBadRequest,
+			Message: "invalid request body",
+			Err:     err,
+		}
+	}
+
+	return applyPipes(r.Context(), value)
+}
+
+// pipeError returns the HTTPError from the pipe error chain
+// or wraps the pipe error into the 400 Bad Request HTTPError with the error message
+func pipeError(err error) *HTTPError {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr
+	}
+
+	return &HTTPError{
+		Status:  http.StatusBadRequest,
+		Message: err.Error(),
+		Err:     err,
+	}
+}
+
+// applyPipes applies the request pipes to the value
+func applyPipes[T any](ctx context.Context, value T) (T, error) {
+	transformed, err := transform(ctx, value, reflect.TypeFor[T]())
+	if err != nil {
+		return value, err
+	}
+
+	res, _ := transformed.(T)
+	return res, nil
+}
+
+// transform applies the request pipes to the value of the provided type.
+//
+// Returns the 500 Internal Server Error HTTPError naming the pipe
+// if the pipe returns nil or the value of another type
+func transform(ctx context.Context, value any, typ reflect.Type) (any, error) {
+	pipes, _ := ctx.Value(pipesKey{}).([]Pipe)
+
+	for _, pipe := range pipes {
+		var err error
+		if value, err = pipe.Transform(ctx, value); err != nil {
+			return nil, pipeError(err)
+		}
+
+		res := reflect.ValueOf(value)
+		if !res.IsValid() || !res.Type().AssignableTo(typ) || isNilPointer(res) {
+			return nil, &HTTPError{
+				Status:  http.StatusInternalServerError,
+				Message: http.StatusText(http.StatusInternalServerError),
+				Err:     fmt.Errorf("web: pipe %T transformed %q into %T", pipe, typ, value),
+			}
+		}
+	}
+
+	return value, nil
+}
+
+// isNilPointer returns true if the value is a nil pointer
+func isNilPointer(val reflect.Value) bool {
+	return val.Kind() == reflect.Pointer && val.IsNil()
+}
@@ -0,0 +1,239 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// callLog is the log of the enhancers calls used in the tests
+type callLog struct {
+	calls []string
+}
+
+// logInterceptor is the Interceptor used in the tests
+type logInterceptor struct {
+	name string
+	log  *callLog
+}
+
+// Intercept implements the Interceptor interface
+func (i *logInterceptor) Intercept(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	i.log.calls = append(i.log.calls, "before "+i.name)
+	next.ServeHTTP(w, r)
+	i.log.calls = append(i.log.calls, "after "+i.name)
+}
+
+// authGuard is the Guard allowing the requests with the token header used in the tests
+type authGuard struct {
+	token string
+}
+
+// CanActivate implements the Guard interface
+func (g *authGuard) CanActivate(r *http.Request) (bool, error) {
+	return r.Header.Get("X-Token") == g.token, nil
+}
+
+// failingGuard is the Guard returning an error used in the tests
+type failingGuard struct{}
+
+// CanActivate implements the Guard interface
+func (failingGuard) CanActivate(*http.Request) (bool, error) {
+	return false, NewHTTPError(http.StatusUnauthorized, "no credentials")
+}
+
+// createUserReq is the request body used in the tests
+type createUserReq struct {
+	Name string `json:"name"`
+}
+
+// trimPipe is the Pipe trimming the request name used in the tests
+type trimPipe struct{}
+
+// Transform implements the Pipe interface
+func (trimPipe) Transform(_ context.Context, value any) (any, error) {
+	req := value.(createUserReq)
+	req.Name = strings.TrimSpace(req.Name)
+	return req, nil
+}
+
+// requiredNamePipe is the Pipe validating the request name used in the tests
+type requiredNamePipe struct{}
+
+// Transform implements the Pipe interface
+func (requiredNamePipe) Transform(_ context.Context, value any) (any, error) {
+	if value.(createUserReq).Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	return value, nil
+}
+
+// adminController is the EnhancedController used in the tests
+type adminController struct {
+	log *callLog
+}
+
+// Enhancers implements the EnhancedController interface
+func (contr *adminController) Enhancers() Enhancers {
+	return Enhancers{
+		Guards:       []Ref[Guard]{UseGuard[*authGuard]()},
+		Interceptors: []Ref[Interceptor]{UseInterceptor[*logInterceptor]()},
+	}
+}
+
+// Routes implements the Controller interface
+func (contr *adminController) Routes() []Route {
+	return []Route{
+		{
+			Method:  http.MethodPost,
+			Pattern: "/users",
+			Handler: http.HandlerFunc(contr.createUser),
+			Enhancers: Enhancers{
+				Pipes: []Ref[Pipe]{UsePipe[requiredNamePipe]()},
+			},
+		},
+		{
+			Method:  http.MethodDelete,
+			Pattern: "/users",
+			Handler: http.HandlerFunc(contr.createUser),
+			Enhancers: Enhancers{
+				Guards: []Ref[Guard]{UseGuard[failingGuard]()},
+			},
+		},
+	}
+}
+
+// createUser handles the POST /users requests
+func (contr *adminController) createUser(w http.ResponseWriter, r *http.Request) {
+	contr.log.calls = append(contr.log.calls, "handler")
+
+	req, err := Bind[createUserReq](r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(req)
+}
+
+// EnhancersSuite is the suite for testing the guards, interceptors and pipes
+type EnhancersSuite struct {
+	suite.Suite
+}
+
+// newHandler creates a new handler with the adminController mounted
+func (suite *EnhancersSuite) newHandler(log *callLog) http.Handler {
+	c := di.NewContainer(
+		di.WithValue(log),
+		di.WithService[Interceptor](&logInterceptor{name: "global", log: log}),
+		di.WithService[Pipe](trimPipe{}),
+		di.WithValue(&logInterceptor{name: "controller", log: log}),
+		di.WithValue(&authGuard{token: "secret"}),
+		di.WithValue(requiredNamePipe{}),
+		di.WithValue(failingGuard{}),
+		di.WithService[Controller](func(log *callLog) *adminController {
+			return &adminController{log: log}
+		}),
+	)
+
+	h, err := NewHandler(c)
+	suite.Require().NoError(err)
+
+	return h
+}
+
+// newRequest creates a new request with the provided body and token
+func (suite *EnhancersSuite) newRequest(method, body, token string) *http.Request {
+	req := httptest.NewRequest(method, "/users", strings.NewReader(body))
+	req.Header.Set("X-Token", token)
+	return req
+}
+
+// TestAllowed tests the enhancers are applied in order
+func (suite *EnhancersSuite) TestAllowed() {
+	// Arrange
+	log := &callLog{}
+	h := suite.newHandler(log)
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, suite.newRequest(http.MethodPost, `{"name": " John "}`, "secret"))
+
+	// Assert
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.JSONEq(`{"name": "John"}`, rec.Body.String())
+	suite.Equal([]string{"before global", "before controller", "handler", "after controller", "after global"}, log.calls)
+}
+
+// TestForbidden tests the guard rejects the request
+func (suite *EnhancersSuite) TestForbidden() {
+	// Arrange
+	log := &callLog{}
+	h := suite.newHandler(log)
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, suite.newRequest(http.MethodPost, `{"name": "John"}`, "wrong"))
+
+	// Assert
+	suite.Equal(http.StatusForbidden, rec.Code)
+	suite.Empty(log.calls)
+}
+
+// TestGuardError tests the guard error status is written
+func (suite *EnhancersSuite) TestGuardError() {
+	// Arrange
+	h := suite.newHandler(&callLog{})
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, suite.newRequest(http.MethodDelete, "", "secret"))
+
+	// Assert
+	suite.Equal(http.StatusUnauthorized, rec.Code)
+	suite.JSONEq(`{"error": "no credentials"}`, rec.Body.String())
+}
+
+// TestPipeRejected tests the pipe rejects the request body
+func (suite *EnhancersSuite) TestPipeRejected() {
+	// Arrange
+	h := suite.newHandler(&callLog{})
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, suite.newRequest(http.MethodPost, `{"name": "  "}`, "secret"))
+
+	// Assert
+	suite.Equal(http.StatusBadRequest, rec.Code)
+	suite.JSONEq(`{"error": "name is required"}`, rec.Body.String())
+}
+
+// TestInvalidBody tests the invalid request body is rejected
+func (suite *EnhancersSuite) TestInvalidBody() {
+	// Arrange
+	h := suite.newHandler(&callLog{})
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, suite.newRequest(http.MethodPost, `{`, "secret"))
+
+	// Assert
+	suite.Equal(http.StatusBadRequest, rec.Code)
+	suite.JSONEq(`{"error": "invalid request body"}`, rec.Body.String())
+}
+
+// TestEnhancers tests the guards, interceptors and pipes
+func TestEnhancers(t *testing.T) {
+	suite.Run(t, new(EnhancersSuite))
+}
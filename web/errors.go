@@ -0,0 +1,76 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package web
+
+import (
+	"errors"
+	"net/http"
+)
+
+// HTTPError is an error carrying the HTTP response status
+type HTTPError struct {
+	// Status is the HTTP response status code
+	Status int
+
+	// Message is the error message sent to the client
+	Message string
+
+	// Err is the underlying error, never sent to the client
+	Err error
+}
+
+// NewHTTPError creates a new HTTPError with the provided status and message
+func NewHTTPError(status int, message string) *HTTPError {
+	return &HTTPError{
+		Status:  status,
+		Message: message,
+	}
+}
+
+// Error implements the error interface for HTTPError.
+func (e *HTTPError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+
+	return e.Message
+}
+
+// Unwrap returns the underlying error
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// asHTTPError returns the HTTPError from the err chain
+// or wraps the err into a new HTTPError with the provided status
+func asHTTPError(err error, status int) *HTTPError {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr
+	}
+
+	return &HTTPError{
+		Status:  status,
+		Message: http.StatusText(status),
+		Err:     err,
+	}
+}
+
+// errorResponse is the JSON error response body
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// WriteError writes the JSON error response.
+//
+// The status and the message are taken from the HTTPError in the err chain,
+// any other error is written as the 500 Internal Server Error without its message
+func WriteError(w http.ResponseWriter, err error) {
+	httpErr := asHTTPError(err, http.StatusInternalServerError)
+
+	_ = WriteJSON(w, httpErr.Status, errorResponse{
+		Error: httpErr.Message,
+	})
+}
@@ -0,0 +1,211 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/akimsavvin/gonet/v2/internal/value"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+const (
+	// pathTag is the request struct tag binding the field to the path parameter
+	pathTag = "path"
+
+	// queryTag is the request struct tag binding the field to the query parameter
+	queryTag = "query"
+)
+
+var (
+	// contextType is the context.Context type
+	contextType = reflect.TypeFor[context.Context]()
+
+	// errorType is the error type
+	errorType = reflect.TypeFor[error]()
+)
+
+// typedHandler is the http.Handler calling the typed handler function
+type typedHandler struct {
+	// fn is the handler function value
+	fn reflect.Value
+
+	// reqType is the request struct type
+	reqType reflect.Type
+
+	// respType is the response type.
+	// nil if the handler returns only an error
+	respType reflect.Type
+
+	// depTypes are the types of the dependencies resolved from the request scope
+	depTypes []reflect.Type
+}
+
+// Handle returns the http.Handler calling the typed handler function.
+//
+// The handler function must be declared as
+//
+//	func(ctx context.Context, req Req, deps ...) (Resp, error)
+//
+// or return only an error. The request struct Req is decoded from the JSON request body,
+// then its fields with the "path" tag are set from the path parameters
+// and the fields with the "query" tag are set from the query parameters,
+// then the request pipes are applied to it. Use struct{} for the handlers without the request.
+// The rest parameters are resolved from the request scope the same way as the factory dependencies.
+//
+// The response is written as JSON with the 200 OK status,
+// the handler returning only an error responds with the 204 No Content status.
+// The errors are written with the WriteError function.
+//
+// Panics if the handler function signature is invalid
+func Handle(fn any) http.Handler {
+	val := reflect.ValueOf(fn)
+	typ := val.Type()
+
+	if typ.Kind() != reflect.Func {
+		log.Panicf("[%T]: handler must be a function\n", fn)
+	}
+
+	if typ.NumIn() < 2 || typ.In(0) != contextType || typ.In(1).Kind() != reflect.Struct {
+		log.Panicf("[%T]: handler must accept the context and the request struct\n", fn)
+	}
+
+	h := &typedHandler{
+		fn:      val,
+		reqType: typ.In(1),
+	}
+
+	for i := 2; i < typ.NumIn(); i++ {
+		h.depTypes = append(h.depTypes, typ.In(i))
+	}
+
+	switch {
+	case typ.NumOut() == 1 && typ.Out(0) == errorType:
+	case typ.NumOut() == 2 && typ.Out(1) == errorType:
+		h.respType = typ.Out(0)
+	default:
+		log.Panicf("[%T]: handler must return the response and an error or only an error\n", fn)
+	}
+
+	return h
+}
+
+// ServeHTTP implements the http.Handler interface
+func (h *typedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := h.decodeRequest(r)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	sg, ok := di.FromContext(ctx)
+	if !ok && len(h.depTypes) > 0 {
+		WriteError(w, di.ErrNoServiceGetter)
+		return
+	}
+
+	args := make([]reflect.Value, 0, 2+len(h.depTypes))
+	args = append(args, reflect.ValueOf(&ctx).Elem(), req)
+	for _, depType := range h.depTypes {
+		dep, err := di.GetServiceValue(ctx, sg, depType)
+		if err != nil {
+			WriteError(w, fmt.Errorf("web: failed to resolve handler dependency %q: %w", depType, err))
+			return
+		}
+
+		args = append(args, dep)
+	}
+
+	out := h.fn.Call(args)
+	if errVal := out[len(out)-1]; !errVal.IsNil() {
+		WriteError(w, errVal.Interface().(error))
+		return
+	}
+
+	if h.respType == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	_ = WriteJSON(w, http.StatusOK, out[0].Interface())
+}
+
+// decodeRequest decodes the request struct from the request body, path and query
+// and applies the request pipes to it
+func (h *typedHandler) decodeRequest(r *http.Request) (reflect.Value, error) {
+	req := reflect.New(h.reqType)
+
+	err := json.NewDecoder(r.Body).Decode(req.Interface())
+	if err != nil && !errors.Is(err, io.EOF) {
+		return req.Elem(), &HTTPError{
+			Status:  http.StatusBadRequest,
+			Message: "invalid request body",
+			Err:     err,
+		}
+	}
+
+	if err = bindParams(r, req.Elem()); err != nil {
+		return req.Elem(), err
+	}
+
+	transformed, err := transform(r.Context(), req.Elem().Interface(), h.reqType)
+	if err != nil {
+		return req.Elem(), err
+	}
+
+	return reflect.ValueOf(transformed), nil
+}
+
+// bindParams sets the request struct fields tagged with the "path" and "query" tags
+func bindParams(r *http.Request, req reflect.Value) error {
+	typ := req.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, raw, ok := lookupParam(r, field)
+		if !ok {
+			continue
+		}
+
+		if err := value.Set(req.Field(i), raw); err != nil {
+			return &HTTPError{
+				Status:  http.StatusBadRequest,
+				Message: fmt.Sprintf("invalid parameter %q", name),
+				Err:     err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// lookupParam returns the name and the raw value of the path or query parameter
+// bound to the request struct field.
+// The multiple query values are joined with commas
+func lookupParam(r *http.Request, field reflect.StructField) (string, string, bool) {
+	if name, ok := field.Tag.Lookup(pathTag); ok {
+		raw := r.PathValue(name)
+		return name, raw, raw != ""
+	}
+
+	if name, ok := field.Tag.Lookup(queryTag); ok {
+		query := r.URL.Query()
+		return name, strings.Join(query[name], ","), query.Has(name)
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,234 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package web
+
+import (
+	"context"
+	"errors"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// userService is the handler dependency used in the tests
+type userService struct {
+	users map[int]string
+}
+
+// updateUserReq is the typed handler request used in the tests
+type updateUserReq struct {
+	ID     int      `json:"-" path:"id"`
+	Notify bool     `json:"-" query:"notify"`
+	Tags   []string `json:"-" query:"tag"`
+	Name   string   `json:"name"`
+}
+
+// userResp is the typed handler response used in the tests
+type userResp struct {
+	ID     int      `json:"id"`
+	Name   string   `json:"name"`
+	Notify bool     `json:"notify"`
+	Tags   []string `json:"tags"`
+}
+
+// typedController is the Controller with the typed handlers used in the tests
+type typedController struct{}
+
+// Routes implements the Controller interface
+func (typedController) Routes() []Route {
+	return []Route{
+		{
+			Method:  http.MethodPut,
+			Pattern: "/users/{id}",
+			Handler: Handle(func(ctx context.Context, req updateUserReq, svc *userService) (userResp, error) {
+				if _, ok := svc.users[req.ID]; !ok {
+					return userResp{}, NewHTTPError(http.StatusNotFound, "user not found")
+				}
+
+				svc.users[req.ID] = req.Name
+				return userResp{ID: req.ID, Name: req.Name, Notify: req.Notify, Tags: req.Tags}, nil
+			}),
+		},
+		{
+			Method:  http.MethodDelete,
+			Pattern: "/users/{id}",
+			Handler: Handle(func(ctx context.Context, req updateUserReq, svc *userService) error {
+				if req.ID == 0 {
+					return errors.New("internal details")
+				}
+
+				delete(svc.users, req.ID)
+				return nil
+			}),
+		},
+	}
+}
+
+// HandleSuite is the suite for testing the Handle function
+type HandleSuite struct {
+	suite.Suite
+}
+
+// newHandler creates a new handler with the typedController mounted
+func (suite *HandleSuite) newHandler(svc *userService) http.Handler {
+	c := di.NewContainer(
+		di.WithValue(svc),
+		di.WithService[Controller](typedController{}),
+	)
+
+	h, err := NewHandler(c)
+	suite.Require().NoError(err)
+
+	return h
+}
+
+// TestResponse tests the request is decoded and the response is encoded
+func (suite *HandleSuite) TestResponse() {
+	// Arrange
+	svc := &userService{users: map[int]string{42: "John"}}
+	h := suite.newHandler(svc)
+	req := httptest.NewRequest(http.MethodPut, "/users/42?notify=true&tag=a&tag=b", strings.NewReader(`{"name": "Jane"}`))
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, req)
+
+	// Assert
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.JSONEq(`{"id": 42, "name": "Jane", "notify": true, "tags": ["a", "b"]}`, rec.Body.String())
+	suite.Equal("Jane", svc.users[42])
+}
+
+// TestHTTPError tests the HTTPError status is written
+func (suite *HandleSuite) TestHTTPError() {
+	// Arrange
+	h := suite.newHandler(&userService{})
+	req := httptest.NewRequest(http.MethodPut, "/users/1", strings.NewReader(`{"name": "Jane"}`))
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, req)
+
+	// Assert
+	suite.Equal(http.StatusNotFound, rec.Code)
+	suite.JSONEq(`{"error": "user not found"}`, rec.Body.String())
+}
+
+// TestInvalidParam tests the invalid path parameter is rejected
+func (suite *HandleSuite) TestInvalidParam() {
+	// Arrange
+	h := suite.newHandler(&userService{})
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/users/abc", nil))
+
+	// Assert
+	suite.Equal(http.StatusBadRequest, rec.Code)
+	suite.JSONEq(`{"error": "invalid parameter \"id\""}`, rec.Body.String())
+}
+
+// TestNoContent tests the handler returning only an error responds with no content
+func (suite *HandleSuite) TestNoContent() {
+	// Arrange
+	svc := &userService{users: map[int]string{42: "John"}}
+	h := suite.newHandler(svc)
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/users/42", nil))
+
+	// Assert
+	suite.Equal(http.StatusNoContent, rec.Code)
+	suite.Empty(svc.users)
+}
+
+// TestInternalError tests the unknown error details are not written
+func (suite *HandleSuite) TestInternalError() {
+	// Arrange
+	h := suite.newHandler(&userService{})
+	rec := httptest.NewRecorder()
+
+	// Act
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/users/0", nil))
+
+	// Assert
+	suite.Equal(http.StatusInternalServerError, rec.Code)
+	suite.JSONEq(`{"error": "Internal Server Error"}`, rec.Body.String())
+}
+
+// nilPipe is the Pipe returning the nil value used in the tests
+type nilPipe struct {
+	value any
+}
+
+// Transform implements the Pipe interface
+func (p nilPipe) Transform(context.Context, any) (any, error) {
+	return p.value, nil
+}
+
+// TestNilPipe tests the pipe returning the nil value is reported as the internal error
+func (suite *HandleSuite) TestNilPipe() {
+	for _, pipe := range []nilPipe{{value: nil}, {value: (*updateUserReq)(nil)}} {
+		// Arrange
+		c := di.NewContainer(
+			di.WithValue(&userService{users: map[int]string{42: "John"}}),
+			di.WithService[Controller](typedController{}),
+			di.WithService[Pipe](pipe),
+		)
+
+		h, err := NewHandler(c)
+		suite.Require().NoError(err)
+
+		req := httptest.NewRequest(http.MethodPut, "/users/42", strings.NewReader(`{"name": "Jane"}`))
+		rec := httptest.NewRecorder()
+
+		// Act
+		h.ServeHTTP(rec, req)
+
+		// Assert
+		suite.Equal(http.StatusInternalServerError, rec.Code)
+		suite.JSONEq(`{"error": "Internal Server Error"}`, rec.Body.String())
+	}
+}
+
+// TestNilPipeError tests the error names the pipe returning the nil value
+func (suite *HandleSuite) TestNilPipeError() {
+	// Arrange
+	ctx := context.WithValue(context.Background(), pipesKey{}, []Pipe{nilPipe{}})
+
+	// Act
+	_, err := transform(ctx, updateUserReq{}, reflect.TypeFor[updateUserReq]())
+
+	// Assert
+	var httpErr *HTTPError
+	if suite.ErrorAs(err, &httpErr) {
+		suite.Equal(http.StatusInternalServerError, httpErr.Status)
+		suite.ErrorContains(httpErr, "pipe web.nilPipe transformed")
+	}
+}
+
+// TestInvalidSignature tests the invalid handler signatures are rejected
+func (suite *HandleSuite) TestInvalidSignature() {
+	// Act & Assert
+	suite.Panics(func() {
+		Handle("handler")
+	})
+	suite.Panics(func() {
+		Handle(func(req updateUserReq) error { return nil })
+	})
+	suite.Panics(func() {
+		Handle(func(ctx context.Context, req updateUserReq) userResp { return userResp{} })
+	})
+}
+
+// TestHandle tests the Handle function
+func TestHandle(t *testing.T) {
+	suite.Run(t, new(HandleSuite))
+}
@@ -0,0 +1,600 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package web
+
+import (
+	"encoding"
+	"encoding/json"
+	"github.com/akimsavvin/gonet/v2/di"
+	"gopkg.in/yaml.v3"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// openAPIVersion is the version of the generated OpenAPI documents
+	openAPIVersion = "3.1.0"
+
+	// validateTag is the struct tag with the field validation rules
+	validateTag = "validate"
+
+	// jsonContentType is the JSON media type
+	jsonContentType = "application/json"
+
+	// errorSchemaName is the name of the error response schema component
+	errorSchemaName = "Error"
+)
+
+var (
+	// timeType is the time.Time type
+	timeType = reflect.TypeFor[time.Time]()
+
+	// textMarshalerType is the encoding.TextMarshaler type
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+
+	// pathParamRegexp matches the http.ServeMux pattern wildcards
+	pathParamRegexp = regexp.MustCompile(`\{([^}]*)}`)
+)
+
+// Document is the OpenAPI 3.1 document
+type Document struct {
+	OpenAPI    string               `json:"openapi" yaml:"openapi"`
+	Info       Info                 `json:"info" yaml:"info"`
+	Paths      map[string]*PathItem `json:"paths" yaml:"paths"`
+	Components Components           `json:"components" yaml:"components"`
+}
+
+// Info is the OpenAPI document metadata
+type Info struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Version     string `json:"version" yaml:"version"`
+}
+
+// PathItem is the operations available on a single path keyed by the lowercase HTTP method
+type PathItem map[string]*Operation
+
+// Operation is the single API operation on a path
+type Operation struct {
+	Summary     string               `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses" yaml:"responses"`
+}
+
+// Parameter is the operation path or query parameter
+type Parameter struct {
+	Name     string  `json:"name" yaml:"name"`
+	In       string  `json:"in" yaml:"in"`
+	Required bool    `json:"required,omitempty" yaml:"required,omitempty"`
+	Schema   *Schema `json:"schema" yaml:"schema"`
+}
+
+// RequestBody is the operation request body
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty" yaml:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content" yaml:"content"`
+}
+
+// Response is the operation response
+type Response struct {
+	Description string                `json:"description" yaml:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty" yaml:"content,omitempty"`
+}
+
+// MediaType is the request or response body media type
+type MediaType struct {
+	Schema *Schema `json:"schema" yaml:"schema"`
+}
+
+// Components holds the reusable document objects
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty" yaml:"schemas,omitempty"`
+}
+
+// Schema is the JSON Schema of the request and response data
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty" yaml:"type,omitempty"`
+	Format               string             `json:"format,omitempty" yaml:"format,omitempty"`
+	Items                *Schema            `json:"items,omitempty" yaml:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty" yaml:"required,omitempty"`
+	Enum                 []any              `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64           `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty" yaml:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+}
+
+// JSON returns the JSON encoded document
+func (doc *Document) JSON() ([]byte, error) {
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// YAML returns the YAML encoded document
+func (doc *Document) YAML() ([]byte, error) {
+	return yaml.Marshal(doc)
+}
+
+// OpenAPI generates the OpenAPI 3.1 document describing the routes
+// of all the controllers added to the Container.
+//
+// The routes handled by the Handle function are documented with their request and response types:
+// the request struct fields with the "path" and "query" tags become the parameters
+// and the rest fields become the JSON request body.
+// The struct schemas are reflected from the "json" tags and the "validate" tags
+// (required, min, max, len, gt, gte, lt, lte, oneof, email, url, uuid)
+// and are added to the document components.
+// The rest routes are documented with their path parameters only.
+// The routes without the method are skipped.
+//
+// The document info defaults to the "API" title and the "1.0.0" version
+// and may be changed before serving the document
+func OpenAPI(c *di.Container) (*Document, error) {
+	routes, err := resolveRoutes(c)
+	if err != nil {
+		return nil, err
+	}
+
+	gen := newSchemaGenerator()
+	doc := &Document{
+		OpenAPI: openAPIVersion,
+		Info: Info{
+			Title:   "API",
+			Version: "1.0.0",
+		},
+		Paths: make(map[string]*PathItem),
+	}
+
+	errorSchema := gen.schema(reflect.TypeFor[errorResponse]())
+	for _, route := range routes {
+		if route.Method == "" {
+			continue
+		}
+
+		path, params := openAPIPath(route.Path)
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+
+		op := &Operation{
+			Summary: route.Summary,
+			Responses: map[string]*Response{
+				"default": {
+					Description: "Error",
+					Content:     jsonContent(errorSchema),
+				},
+			},
+		}
+
+		if h, ok := route.Handler.(*typedHandler); ok {
+			gen.describeHandler(op, h)
+		} else {
+			for _, name := range params {
+				op.Parameters = append(op.Parameters, &Parameter{
+					Name:     name,
+					In:       pathTag,
+					Required: true,
+					Schema:   &Schema{Type: "string"},
+				})
+			}
+
+			op.Responses[strconv.Itoa(http.StatusOK)] = &Response{Description: http.StatusText(http.StatusOK)}
+		}
+
+		(*item)[strings.ToLower(route.Method)] = op
+	}
+
+	doc.Components.Schemas = gen.components
+	return doc, nil
+}
+
+// OpenAPIHandler returns the http.Handler serving the document.
+// The document is served as JSON or as YAML if the "format" query parameter is "yaml"
+func OpenAPIHandler(doc *Document) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType := jsonContentType
+		encode := doc.JSON
+		if r.URL.Query().Get("format") == "yaml" {
+			contentType = "application/yaml"
+			encode = doc.YAML
+		}
+
+		data, err := encode()
+		if err != nil {
+			WriteError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(data)
+	})
+}
+
+// openAPIPath converts the http.ServeMux path pattern into the OpenAPI path
+// and returns the names of its parameters
+func openAPIPath(pattern string) (string, []string) {
+	pattern = strings.TrimSuffix(pattern, "{$}")
+
+	var params []string
+	path := pathParamRegexp.ReplaceAllStringFunc(pattern, func(wildcard string) string {
+		name := strings.TrimSuffix(wildcard[1:len(wildcard)-1], "...")
+		params = append(params, name)
+		return "{" + name + "}"
+	})
+
+	return path, params
+}
+
+// jsonContent returns the JSON media type content with the schema
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{
+		jsonContentType: {Schema: schema},
+	}
+}
+
+// schemaGenerator reflects the types into the schemas
+// and collects the named struct schemas into the components
+type schemaGenerator struct {
+	// components are the named struct schemas
+	components map[string]*Schema
+
+	// names are the component names of the reflected struct types
+	names map[reflect.Type]string
+}
+
+// newSchemaGenerator creates a new schemaGenerator
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		components: make(map[string]*Schema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+// describeHandler documents the typed handler request and response
+func (gen *schemaGenerator) describeHandler(op *Operation, h *typedHandler) {
+	body := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+
+	for _, field := range structFields(h.reqType) {
+		schema := gen.fieldSchema(field)
+		required := isRequired(field)
+
+		if name, ok := field.Tag.Lookup(pathTag); ok {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:     name,
+				In:       pathTag,
+				Required: true,
+				Schema:   schema,
+			})
+		} else if name, ok = field.Tag.Lookup(queryTag); ok {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:     name,
+				In:       queryTag,
+				Required: required,
+				Schema:   schema,
+			})
+		} else if name, ok = jsonName(field); ok {
+			body.Properties[name] = schema
+			if required {
+				body.Required = append(body.Required, name)
+			}
+		}
+	}
+
+	if len(body.Properties) > 0 {
+		op.RequestBody = &RequestBody{
+			Required: len(body.Required) > 0,
+			Content:  jsonContent(body),
+		}
+	}
+
+	if h.respType == nil {
+		op.Responses[strconv.Itoa(http.StatusNoContent)] = &Response{
+			Description: http.StatusText(http.StatusNoContent),
+		}
+		return
+	}
+
+	op.Responses[strconv.Itoa(http.StatusOK)] = &Response{
+		Description: http.StatusText(http.StatusOK),
+		Content:     jsonContent(gen.schema(h.respType)),
+	}
+}
+
+// schema returns the schema of the type.
+// The named struct types are added to the components and referenced
+func (gen *schemaGenerator) schema(typ reflect.Type) *Schema {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case typ == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case typ.Implements(textMarshalerType) || reflect.PointerTo(typ).Implements(textMarshalerType):
+		return &Schema{Type: "string"}
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+
+		return &Schema{Type: "array", Items: gen.schema(typ.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: gen.schema(typ.Elem())}
+	case reflect.Struct:
+		return gen.structSchema(typ)
+	default:
+		return &Schema{}
+	}
+}
+
+// structSchema returns the schema of the struct type.
+// The anonymous structs are inlined, the named structs are referenced from the components
+func (gen *schemaGenerator) structSchema(typ reflect.Type) *Schema {
+	if typ.Name() == "" {
+		return gen.objectSchema(typ)
+	}
+
+	name, ok := gen.names[typ]
+	if !ok {
+		name = gen.componentName(typ)
+		gen.names[typ] = name
+		gen.components[name] = &Schema{}
+		*gen.components[name] = *gen.objectSchema(typ)
+	}
+
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName returns the unique component name of the named struct type
+func (gen *schemaGenerator) componentName(typ reflect.Type) string {
+	name := sanitizeComponentName(typ.Name())
+	if typ == reflect.TypeFor[errorResponse]() {
+		name = errorSchemaName
+	}
+
+	if _, taken := gen.components[name]; !taken {
+		return name
+	}
+
+	pkg := typ.PkgPath()
+	pkg = sanitizeComponentName(pkg[strings.LastIndex(pkg, "/")+1:])
+	name = pkg + "." + name
+
+	unique := name
+	for i := 2; ; i++ {
+		if _, taken := gen.components[unique]; !taken {
+			return unique
+		}
+
+		unique = name + strconv.Itoa(i)
+	}
+}
+
+// typeNameToken matches the type names in the generic type name, e.g. Page[github.com/app/dto.User]
+var typeNameToken = regexp.MustCompile(`[^\[\]*, ]+`)
+
+// invalidComponentChar matches the characters not allowed in the component names
+var invalidComponentChar = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// sanitizeComponentName returns the component name of the type name
+// matching the ^[a-zA-Z0-9.\-_]+$ pattern required by the OpenAPI.
+//
+// The generic type arguments are appended without the packages, e.g. Page[github.com/app/dto.User] is Page_User
+func sanitizeComponentName(name string) string {
+	tokens := typeNameToken.FindAllString(name, -1)
+	for i, token := range tokens {
+		token = token[strings.LastIndex(token, "/")+1:]
+		tokens[i] = token[strings.LastIndex(token, ".")+1:]
+	}
+
+	return invalidComponentChar.ReplaceAllString(strings.Join(tokens, "_"), "_")
+}
+
+// objectSchema returns the object schema with the struct type properties
+func (gen *schemaGenerator) objectSchema(typ reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+
+	for _, field := range structFields(typ) {
+		name, ok := jsonName(field)
+		if !ok {
+			continue
+		}
+
+		schema.Properties[name] = gen.fieldSchema(field)
+		if isRequired(field) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+// fieldSchema returns the schema of the struct field with the validation rules applied
+func (gen *schemaGenerator) fieldSchema(field reflect.StructField) *Schema {
+	schema := gen.schema(field.Type)
+	rules, ok := field.Tag.Lookup(validateTag)
+	if !ok || schema.Ref != "" {
+		return schema
+	}
+
+	for _, rule := range strings.Split(rules, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		applyRule(schema, name, param)
+	}
+
+	return schema
+}
+
+// applyRule applies the validation rule to the schema
+func applyRule(schema *Schema, rule, param string) {
+	switch rule {
+	case "email":
+		schema.Format = "email"
+	case "url", "uri":
+		schema.Format = "uri"
+	case "uuid":
+		schema.Format = "uuid"
+	case "oneof":
+		for _, option := range strings.Fields(param) {
+			schema.Enum = append(schema.Enum, enumValue(schema.Type, option))
+		}
+	case "min", "max", "len":
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return
+		}
+
+		if rule != "max" {
+			setBound(schema, n, true)
+		}
+
+		if rule != "min" {
+			setBound(schema, n, false)
+		}
+	case "gte", "lte":
+		if n, err := strconv.ParseFloat(param, 64); err == nil {
+			setBound(schema, n, rule == "gte")
+		}
+	case "gt":
+		if n, err := strconv.ParseFloat(param, 64); err == nil {
+			schema.ExclusiveMinimum = &n
+		}
+	case "lt":
+		if n, err := strconv.ParseFloat(param, 64); err == nil {
+			schema.ExclusiveMaximum = &n
+		}
+	}
+}
+
+// setBound sets the lower or upper bound of the schema depending on its type:
+// the length for the strings, the items count for the arrays and the value for the numbers
+func setBound(schema *Schema, n float64, lower bool) {
+	count := int(n)
+
+	switch schema.Type {
+	case "string":
+		if lower {
+			schema.MinLength = &count
+		} else {
+			schema.MaxLength = &count
+		}
+	case "array":
+		if lower {
+			schema.MinItems = &count
+		} else {
+			schema.MaxItems = &count
+		}
+	case "integer", "number":
+		if lower {
+			schema.Minimum = &n
+		} else {
+			schema.Maximum = &n
+		}
+	}
+}
+
+// enumValue converts the oneof rule option into the enum value of the schema type
+func enumValue(typ, option string) any {
+	switch typ {
+	case "integer":
+		if n, err := strconv.ParseInt(option, 10, 64); err == nil {
+			return n
+		}
+	case "number":
+		if n, err := strconv.ParseFloat(option, 64); err == nil {
+			return n
+		}
+	}
+
+	return option
+}
+
+// structFields returns the exported struct fields
+// with the fields of the embedded structs without the json name promoted
+func structFields(typ reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				fields = append(fields, structFields(embedded)...)
+				continue
+			}
+		}
+
+		if field.IsExported() {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// jsonName returns the JSON property name of the struct field.
+// Returns false if the field is skipped by the "json" tag
+func jsonName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, true
+}
+
+// isRequired reports whether the struct field has the "required" validation rule
+func isRequired(field reflect.StructField) bool {
+	for _, rule := range strings.Split(field.Tag.Get(validateTag), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,306 @@
+// 🔥 GoNet is the first full-fledged framework made for Golang!
+// ⚡️ GoNet is inspired by .NET, NestJS and other languages frameworks
+// 🤖 GitHub Repository: https://github.com/akimsavvin/gonet
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/akimsavvin/gonet/v2/di"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// address is the nested DTO used in the OpenAPI tests
+type address struct {
+	City string `json:"city" validate:"required"`
+}
+
+// createOrderReq is the typed handler request used in the OpenAPI tests
+type createOrderReq struct {
+	ShopID   string   `json:"-" path:"shop"`
+	DryRun   bool     `json:"-" query:"dry_run"`
+	Email    string   `json:"email" validate:"required,email"`
+	Quantity int      `json:"quantity" validate:"min=1,max=10"`
+	Status   string   `json:"status,omitempty" validate:"oneof=new paid"`
+	Items    []string `json:"items" validate:"max=5"`
+	Address  *address `json:"address"`
+	internal string
+}
+
+// orderResp is the typed handler response used in the OpenAPI tests
+type orderResp struct {
+	ID        int       `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Secret    string    `json:"-"`
+}
+
+// orderController is the Controller used in the OpenAPI tests
+type orderController struct{}
+
+// Prefix implements the Prefixer interface
+func (orderController) Prefix() string {
+	return "/shops/{shop}"
+}
+
+// Routes implements the Controller interface
+func (orderController) Routes() []Route {
+	return []Route{
+		{
+			Method:  http.MethodPost,
+			Pattern: "/orders",
+			Summary: "Create an order",
+			Handler: Handle(func(ctx context.Context, req createOrderReq) (orderResp, error) {
+				return orderResp{}, nil
+			}),
+		},
+		{
+			Method:  http.MethodDelete,
+			Pattern: "/orders/{id}",
+			Handler: Handle(func(ctx context.Context, req struct {
+				ID int `path:"id"`
+			}) error {
+				return nil
+			}),
+		},
+		{
+			Method:  http.MethodGet,
+			Pattern: "/files/{path...}",
+			Handler: http.NotFoundHandler(),
+		},
+		{
+			Pattern: "/any",
+			Handler: http.NotFoundHandler(),
+		},
+	}
+}
+
+// page is the generic response used in the OpenAPI tests
+type page[T any] struct {
+	Items []T `json:"items"`
+	Total int `json:"total"`
+}
+
+// pageController is the Controller with the generic responses used in the OpenAPI tests
+type pageController struct{}
+
+// Routes implements the Controller interface
+func (pageController) Routes() []Route {
+	return []Route{
+		{
+			Method:  http.MethodGet,
+			Pattern: "/orders",
+			Handler: Handle(func(ctx context.Context, req struct{}) (page[orderResp], error) {
+				return page[orderResp]{}, nil
+			}),
+		},
+		{
+			Method:  http.MethodGet,
+			Pattern: "/orders/pointers",
+			Handler: Handle(func(ctx context.Context, req struct{}) (page[*orderResp], error) {
+				return page[*orderResp]{}, nil
+			}),
+		},
+		{
+			Method:  http.MethodGet,
+			Pattern: "/times",
+			Handler: Handle(func(ctx context.Context, req struct{}) (page[map[string]time.Time], error) {
+				return page[map[string]time.Time]{}, nil
+			}),
+		},
+	}
+}
+
+// OpenAPISuite is the suite for testing the OpenAPI document generation
+type OpenAPISuite struct {
+	suite.Suite
+	doc *Document
+}
+
+// SetupTest generates the document for the orderController
+func (suite *OpenAPISuite) SetupTest() {
+	c := di.NewContainer(
+		di.WithService[Controller](orderController{}),
+	)
+
+	doc, err := OpenAPI(c)
+	suite.Require().NoError(err)
+	suite.doc = doc
+}
+
+// TestInfo tests that the document has the default info
+func (suite *OpenAPISuite) TestInfo() {
+	// Assert
+	suite.Equal("3.1.0", suite.doc.OpenAPI)
+	suite.Equal("API", suite.doc.Info.Title)
+	suite.Equal("1.0.0", suite.doc.Info.Version)
+}
+
+// TestPaths tests that the routes with the method are documented on the OpenAPI paths
+func (suite *OpenAPISuite) TestPaths() {
+	// Assert
+	suite.Len(suite.doc.Paths, 3)
+	suite.Contains(*suite.doc.Paths["/shops/{shop}/orders"], "post")
+	suite.Contains(*suite.doc.Paths["/shops/{shop}/orders/{id}"], "delete")
+	suite.Contains(*suite.doc.Paths["/shops/{shop}/files/{path}"], "get")
+}
+
+// TestTypedHandler tests that the typed handler request and response are documented
+func (suite *OpenAPISuite) TestTypedHandler() {
+	// Act
+	op := (*suite.doc.Paths["/shops/{shop}/orders"])["post"]
+
+	// Assert
+	suite.Equal("Create an order", op.Summary)
+	suite.Equal([]*Parameter{
+		{Name: "shop", In: "path", Required: true, Schema: &Schema{Type: "string"}},
+		{Name: "dry_run", In: "query", Schema: &Schema{Type: "boolean"}},
+	}, op.Parameters)
+
+	suite.Require().NotNil(op.RequestBody)
+	suite.True(op.RequestBody.Required)
+	body := op.RequestBody.Content["application/json"].Schema
+	suite.Equal([]string{"email"}, body.Required)
+	suite.Len(body.Properties, 5)
+	suite.Equal("#/components/schemas/address", body.Properties["address"].Ref)
+
+	suite.Equal("#/components/schemas/orderResp", op.Responses["200"].Content["application/json"].Schema.Ref)
+	suite.Equal("#/components/schemas/Error", op.Responses["default"].Content["application/json"].Schema.Ref)
+}
+
+// TestValidationRules tests that the validation tags are reflected into the schemas
+func (suite *OpenAPISuite) TestValidationRules() {
+	// Arrange
+	one, ten, five := 1.0, 10.0, 5
+
+	// Act
+	op := (*suite.doc.Paths["/shops/{shop}/orders"])["post"]
+	props := op.RequestBody.Content["application/json"].Schema.Properties
+
+	// Assert
+	suite.Equal(&Schema{Type: "string", Format: "email"}, props["email"])
+	suite.Equal(&Schema{Type: "integer", Format: "int64", Minimum: &one, Maximum: &ten}, props["quantity"])
+	suite.Equal(&Schema{Type: "string", Enum: []any{"new", "paid"}}, props["status"])
+	suite.Equal(&Schema{Type: "array", Items: &Schema{Type: "string"}, MaxItems: &five}, props["items"])
+}
+
+// TestComponents tests that the named structs are added to the components
+func (suite *OpenAPISuite) TestComponents() {
+	// Act
+	schemas := suite.doc.Components.Schemas
+
+	// Assert
+	suite.Equal(&Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{"city": {Type: "string"}},
+		Required:   []string{"city"},
+	}, schemas["address"])
+
+	suite.Equal(&Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"id":         {Type: "integer", Format: "int64"},
+			"created_at": {Type: "string", Format: "date-time"},
+		},
+	}, schemas["orderResp"])
+
+	suite.Contains(schemas, "Error")
+}
+
+// TestGenericComponents tests that the generic types component names are valid and unique
+func (suite *OpenAPISuite) TestGenericComponents() {
+	// Arrange
+	c := di.NewContainer(di.WithService[Controller](pageController{}))
+
+	// Act
+	doc, err := OpenAPI(c)
+
+	// Assert
+	suite.Require().NoError(err)
+
+	schemas := doc.Components.Schemas
+	suite.Contains(schemas, "page_orderResp")
+	suite.Contains(schemas, "web.page_orderResp")
+	suite.Contains(schemas, "page_map_string_Time")
+
+	valid := regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+	for name := range schemas {
+		suite.Regexp(valid, name)
+	}
+
+	ref := (*doc.Paths["/orders"])["get"].Responses["200"].Content["application/json"].Schema.Ref
+	suite.Equal("#/components/schemas/page_orderResp", ref)
+}
+
+// TestNoContent tests that the handler returning only an error is documented with the 204 response
+func (suite *OpenAPISuite) TestNoContent() {
+	// Act
+	op := (*suite.doc.Paths["/shops/{shop}/orders/{id}"])["delete"]
+
+	// Assert
+	suite.Nil(op.RequestBody)
+	suite.Contains(op.Responses, "204")
+	suite.Len(op.Parameters, 1)
+	suite.Equal(&Schema{Type: "integer", Format: "int64"}, op.Parameters[0].Schema)
+}
+
+// TestPlainHandler tests that the plain handler is documented with its path parameters
+func (suite *OpenAPISuite) TestPlainHandler() {
+	// Act
+	op := (*suite.doc.Paths["/shops/{shop}/files/{path}"])["get"]
+
+	// Assert
+	suite.Len(op.Parameters, 2)
+	suite.Equal("path", op.Parameters[1].Name)
+	suite.Contains(op.Responses, "200")
+}
+
+// TestOpenAPIHandler tests that the document is served as JSON and YAML
+func (suite *OpenAPISuite) TestOpenAPIHandler() {
+	// Arrange
+	h := OpenAPIHandler(suite.doc)
+
+	for _, tc := range []struct {
+		target      string
+		contentType string
+		unmarshal   func([]byte, any) error
+	}{
+		{"/openapi", "application/json", json.Unmarshal},
+		{"/openapi?format=yaml", "application/yaml", yaml.Unmarshal},
+	} {
+		rec := httptest.NewRecorder()
+
+		// Act
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+		// Assert
+		suite.Equal(http.StatusOK, rec.Code)
+		suite.Equal(tc.contentType, rec.Header().Get("Content-Type"))
+
+		var doc map[string]any
+		suite.Require().NoError(tc.unmarshal(rec.Body.Bytes(), &doc))
+		suite.Equal("3.1.0", doc["openapi"])
+		suite.Contains(doc["paths"], "/shops/{shop}/orders")
+	}
+}
+
+// TestNoControllers tests that the document is generated without the controllers
+func (suite *OpenAPISuite) TestNoControllers() {
+	// Act
+	doc, err := OpenAPI(di.NewContainer())
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(doc.Paths)
+}
+
+// TestOpenAPI runs the OpenAPISuite
+func TestOpenAPI(t *testing.T) {
+	suite.Run(t, new(OpenAPISuite))
+}